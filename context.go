@@ -14,6 +14,7 @@ func (err *MainError) DocumentPath() string {
 type ContextData struct {
 	*Store
 	Analyzer            LanguageAnalyzer
+	Languages           *LanguageRegistry
 	WorkingPath         string
 	CurrentDocumentPath string
 	Variables           map[string]string
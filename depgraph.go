@@ -1,6 +1,9 @@
 package errgoengine
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+)
 
 type DepGraph map[string]*DepNode
 
@@ -106,6 +109,48 @@ func (graph DepGraph) Delete(path string) {
 	delete(graph, path)
 }
 
+// Cycle returns the shortest chain of dependencies which starts and ends
+// at the given path (eg. a -> b -> a) or nil if the path does not depend
+// on itself.
+func (graph DepGraph) Cycle(path string) []string {
+	node, ok := graph[path]
+	if !ok {
+		return nil
+	}
+
+	// search breadth-first so that the shortest chain is found first
+	previous := map[string]string{}
+	queue := []*DepNode{node}
+	for len(queue) != 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		depPaths := make([]string, 0, len(current.Dependencies))
+		for _, depPath := range current.Dependencies {
+			depPaths = append(depPaths, depPath)
+		}
+		sort.Strings(depPaths)
+
+		for _, depPath := range depPaths {
+			if depPath == path {
+				cycle := []string{path}
+				for p := current.Path; p != path; p = previous[p] {
+					cycle = append([]string{p}, cycle...)
+				}
+				return append([]string{path}, cycle...)
+			}
+
+			if _, visited := previous[depPath]; visited || !graph.Has(depPath) {
+				continue
+			}
+
+			previous[depPath] = current.Path
+			queue = append(queue, graph[depPath])
+		}
+	}
+	return nil
+}
+
 func (graph DepGraph) Detach(path string, dep string) error {
 	return graph[path].Detach(dep)
 }
@@ -36,3 +36,17 @@ func TestDepGraph(t *testing.T) {
 	testutils.Equals(t, graph.Has("a"), true)
 	testutils.Equals(t, graph.Has("c"), false)
 }
+
+func TestDepGraphCycle(t *testing.T) {
+	graph := DepGraph{}
+	graph.Add("a", map[string]string{"b": "b"})
+	graph.Add("b", map[string]string{"c": "c", "d": "d"})
+	graph.Add("c", map[string]string{"a": "a"})
+	graph.Add("e", map[string]string{"e": "e"})
+
+	testutils.EqualsList(t, graph.Cycle("a"), []string{"a", "b", "c", "a"})
+	testutils.EqualsList(t, graph.Cycle("b"), []string{"b", "c", "a", "b"})
+	testutils.EqualsList(t, graph.Cycle("e"), []string{"e", "e"})
+	testutils.Equals(t, len(graph.Cycle("d")), 0)
+	testutils.Equals(t, len(graph.Cycle("f")), 0)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io/fs"
+	"path/filepath"
 
 	sitter "github.com/smacker/go-tree-sitter"
 )
@@ -11,6 +12,7 @@ import (
 type ErrgoEngine struct {
 	SharedStore    *Store
 	ErrorTemplates ErrorTemplates
+	Languages      *LanguageRegistry
 	FS             *MultiReadFileFS
 	OutputGen      *OutputGenerator
 	IsTesting      bool
@@ -20,6 +22,7 @@ func New() *ErrgoEngine {
 	return &ErrgoEngine{
 		SharedStore:    NewEmptyStore(),
 		ErrorTemplates: ErrorTemplates{},
+		Languages:      NewLanguageRegistry(),
 		FS: &MultiReadFileFS{
 			FSs: []fs.ReadFileFS{
 				&RawFS{},
@@ -38,8 +41,9 @@ func (e *ErrgoEngine) AttachMainFS(instance fs.ReadFileFS) {
 			return nil
 		}
 
-		// delete document
+		// delete document and its symbols
 		delete(e.SharedStore.Documents, path)
+		delete(e.SharedStore.Symbols, path)
 		return nil
 	})
 
@@ -47,11 +51,48 @@ func (e *ErrgoEngine) AttachMainFS(instance fs.ReadFileFS) {
 	e.FS.Attach(instance, 0)
 }
 
+// LoadErrorTemplates loads the error templates with the loaders and registers
+// their languages. Other languages (eg. for mixed-language projects) can be
+// registered through the Languages registry.
+func (e *ErrgoEngine) LoadErrorTemplates(loaders ...func(errorTemplates *ErrorTemplates)) {
+	for _, load := range loaders {
+		load(&e.ErrorTemplates)
+	}
+
+	// the keys are sorted so that the languages are registered in the same order every time
+	for _, key := range e.ErrorTemplates.Keys() {
+		e.Languages.Register(e.ErrorTemplates[key].Language)
+	}
+}
+
 func (e *ErrgoEngine) Analyze(workingPath, msg string) (*CompiledErrorTemplate, *ContextData, error) {
-	template := e.ErrorTemplates.Match(msg)
+	// guess the language from the files mentioned in the message
+	// before looking for the matching template
+	candidateLanguages := e.Languages.Detect(msg, func(path string) bool {
+		if len(workingPath) != 0 && !filepath.IsAbs(path) {
+			path = filepath.Join(workingPath, path)
+		}
+		_, err := fs.Stat(e.FS, path)
+		return err == nil
+	})
+
+	// convert messages from the other output formats of the detected
+	// languages (eg. JSON diagnostics) into the format of their templates.
+	// only the most likely language which recognizes the format converts it
+	for _, lang := range candidateLanguages {
+		if lang.MessageConverter == nil {
+			continue
+		} else if converted := lang.MessageConverter(msg); converted != msg {
+			msg = converted
+			break
+		}
+	}
+
+	template := e.ErrorTemplates.MatchByLanguages(candidateLanguages, msg)
 
 	// initial context data extraction
 	contextData := NewContextData(e.SharedStore, workingPath)
+	contextData.Languages = e.Languages
 	contextData.AddVariable("message", msg)
 	contextData.FS = e.FS
 
@@ -187,6 +228,11 @@ func ParseFiles(contextData *ContextData, defaultLanguage *Language, files fs.Re
 	parser := sitter.NewParser()
 	analyzer := &SymbolAnalyzer{ContextData: contextData}
 
+	// files with no matching language are skipped (eg. C extensions in
+	// mixed-language stack traces) and only reported if no file is parsed
+	var parseErr error
+	hasDocuments := false
+
 	for _, path := range fileNames {
 		contents, err := files.ReadFile(path)
 		if err != nil {
@@ -205,6 +251,7 @@ func ParseFiles(contextData *ContextData, defaultLanguage *Language, files fs.Re
 
 		if docExists && existingDoc.BytesContentEquals(contents) {
 			// do not parse if content is the same
+			hasDocuments = true
 			continue
 		}
 
@@ -213,8 +260,15 @@ func ParseFiles(contextData *ContextData, defaultLanguage *Language, files fs.Re
 		if docExists {
 			selectedLanguage = existingDoc.Language
 		} else {
-			if !selectedLanguage.MatchPath(path) {
-				return fmt.Errorf("no language found for %s", path)
+			if selectedLanguage == nil || !selectedLanguage.MatchPath(path) {
+				selectedLanguage = contextData.Languages.MatchPath(path)
+			}
+
+			if selectedLanguage == nil {
+				if parseErr == nil {
+					parseErr = fmt.Errorf("no language found for %s", path)
+				}
+				continue
 			}
 
 			// compile language first (if not yet)
@@ -234,9 +288,13 @@ func ParseFiles(contextData *ContextData, defaultLanguage *Language, files fs.Re
 		}
 
 		analyzer.Analyze(doc)
+		hasDocuments = true
 	}
 
-	return nil
+	if hasDocuments {
+		return nil
+	}
+	return parseErr
 }
 
 func ParseFromStackTrace(contextData *ContextData, defaultLanguage *Language, files fs.ReadFileFS) error {
@@ -169,6 +169,61 @@ func TestParseFromStackTrace(t *testing.T) {
 		}
 	})
 
+	t.Run("MixedLanguages", func(t *testing.T) {
+		currentLang := python.Language
+		contextData := Setup(currentLang, "main.py", lib.Position{Line: 2})
+		contextData.Languages = lib.NewLanguageRegistry(python.Language, java.Language)
+		contextData.TraceStack.Add("run", lib.Location{
+			DocumentPath: "ext.c",
+			StartPos:     lib.Position{Line: 1},
+			EndPos:       lib.Position{Line: 1},
+		})
+		contextData.TraceStack.Add("main", lib.Location{
+			DocumentPath: "Main.java",
+			StartPos:     lib.Position{Line: 3},
+			EndPos:       lib.Position{Line: 3},
+		})
+
+		files := fstest.MapFS{
+			"main.py": &fstest.MapFile{
+				Data: []byte(`import ext
+ext.run()`),
+			},
+			"ext.c": &fstest.MapFile{
+				Data: []byte(`void run() {}`),
+			},
+			"Main.java": &fstest.MapFile{
+				Data: []byte(`public class Main {
+	public static void main(String[] args) {
+		System.out.println(1);
+	}
+}`),
+			},
+		}
+
+		// files with unknown languages are skipped and do not
+		// stop the rest of the files from being parsed
+		if err := lib.ParseFromStackTrace(contextData, currentLang, files); err != nil {
+			t.Fatal(err)
+		}
+
+		if doc, ok := contextData.Documents["main.py"]; !ok {
+			t.Error("main.py document not found")
+		} else if doc.Language != python.Language {
+			t.Errorf("expected language %s, got %s", python.Language.Name, doc.Language.Name)
+		}
+
+		if doc, ok := contextData.Documents["Main.java"]; !ok {
+			t.Error("Main.java document not found")
+		} else if doc.Language != java.Language {
+			t.Errorf("expected language %s, got %s", java.Language.Name, doc.Language.Name)
+		}
+
+		if _, ok := contextData.Documents["ext.c"]; ok {
+			t.Error("ext.c document is parsed")
+		}
+	})
+
 	t.Run("ExistingDoc", func(t *testing.T) {
 		currentLang := python.Language
 		contextData := Setup(currentLang, "hello.py", lib.Position{Line: 2})
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 )
 
@@ -84,12 +85,27 @@ func (tmp *CompiledErrorTemplate) ExtractStackTrace(cd *ContextData) TraceStack
 		rawPath := submatches[pathGroupIdx]
 		rawPos := submatches[posGroupIdx]
 
+		// skip frames that do not point to a source file (eg. external libraries)
+		if len(rawPath) == 0 {
+			continue
+		}
+
 		// convert relative paths to absolute for parsing
 		if len(workingPath) != 0 && !filepath.IsAbs(rawPath) {
 			rawPath = filepath.Clean(filepath.Join(workingPath, rawPath))
 		}
 
-		stLoc := tmp.Language.LocationConverter(LocationConverterContext{
+		// frames from other languages (eg. native extensions) are
+		// converted using the location converter of their own language
+		frameLanguage := tmp.Language
+		if !frameLanguage.MatchPath(rawPath) {
+			if lang := cd.Languages.MatchPath(rawPath); lang != nil {
+				lang.Compile()
+				frameLanguage = lang
+			}
+		}
+
+		stLoc := frameLanguage.LocationConverter(LocationConverterContext{
 			Path:        rawPath,
 			Pos:         rawPos,
 			ContextData: cd,
@@ -114,7 +130,7 @@ const defaultStackTraceRegexOpening = `(?P<stacktrace>(?:`
 const defaultStackTraceRegexClosing = `)*)`
 const defaultStackTraceRegex = defaultStackTraceRegexOpening + `.|\s` + defaultStackTraceRegexClosing
 
-var stackTraceCaptureGroupRegex = regexp.MustCompile(`\(\?P<(?:symbol|path|position)>([a-z0-9A-Z_\\//+.]+)\)`)
+var stackTraceCaptureGroupRegex = regexp.MustCompile(`\(\?P<(?:symbol|path|position)>([^()]+)\)`)
 
 func (tmps *ErrorTemplates) Add(language *Language, template ErrorTemplate) (*CompiledErrorTemplate, error) {
 	key := TemplateKey(language.Name, template.Name)
@@ -198,15 +214,40 @@ func (tmps ErrorTemplates) MustAdd(language *Language, template ErrorTemplate) *
 	return tmp
 }
 
+// Keys returns the keys of the templates in sorted order. Templates are
+// looked up in this order so that the same template is matched every time.
+func (tmps ErrorTemplates) Keys() []string {
+	keys := make([]string, 0, len(tmps))
+	for key := range tmps {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func (tmps ErrorTemplates) Match(msg string) *CompiledErrorTemplate {
-	for _, tmp := range tmps {
-		if tmp.Match(msg) {
+	for _, key := range tmps.Keys() {
+		if tmp := tmps[key]; tmp.Match(msg) {
 			return tmp
 		}
 	}
 	return nil
 }
 
+// MatchByLanguages is similar to Match but gives priority to the templates
+// of the given languages in their order before looking at the rest
+func (tmps ErrorTemplates) MatchByLanguages(languages []*Language, msg string) *CompiledErrorTemplate {
+	keys := tmps.Keys()
+	for _, lang := range languages {
+		for _, key := range keys {
+			if tmp := tmps[key]; tmp.Language == lang && tmp.Match(msg) {
+				return tmp
+			}
+		}
+	}
+	return tmps.Match(msg)
+}
+
 func (tmps ErrorTemplates) Find(language, name string) *CompiledErrorTemplate {
 	key := TemplateKey(language, name)
 	tmp, exists := tmps[key]
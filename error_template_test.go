@@ -507,6 +507,18 @@ func TestErrorTemplates(t *testing.T) {
 		}
 	})
 
+	t.Run("Keys", func(t *testing.T) {
+		keys := errorTemplates.Keys()
+		expected := []string{
+			lib.TemplateKey(lib.TestLanguage.Name, "ErrorA"),
+			lib.TemplateKey(lib.TestLanguage.Name, "ErrorB"),
+		}
+
+		if !reflect.DeepEqual(keys, expected) {
+			t.Fatalf("expected %v, got %v", expected, keys)
+		}
+	})
+
 	t.Run("Stacked", func(t *testing.T) {
 		inputs := []string{
 			"This is a sample error",
@@ -0,0 +1,83 @@
+package csharp
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/languages/csharp"
+)
+
+func LoadErrorTemplates(errorTemplates *lib.ErrorTemplates) {
+	// Runtime
+	errorTemplates.MustAdd(csharp.Language, NullReferenceException)
+	errorTemplates.MustAdd(csharp.Language, IndexOutOfRangeException)
+
+	// Compile time
+	errorTemplates.MustAdd(csharp.Language, NameNotFoundError)
+	errorTemplates.MustAdd(csharp.Language, ImplicitConversionError)
+	errorTemplates.MustAdd(csharp.Language, SemicolonExpectedError)
+	errorTemplates.MustAdd(csharp.Language, UnassignedLocalVariableError)
+}
+
+func runtimeErrorPattern(errorName string, pattern string) string {
+	p := fmt.Sprintf(
+		`Unhandled [eE]xception[.:] %s`,
+		strings.ReplaceAll(errorName, ".", `\.`),
+	)
+	if len(pattern) != 0 {
+		p += ": " + pattern
+	}
+	return p
+}
+
+// compile errors from both csc and `dotnet build` are in the form of
+// Program.cs(12,17): error CS0103: <message> [/path/to/Project.csproj]
+const comptimeStackTracePattern = `(?P<path>\S+)\((?P<position>\d+,\d+)\)`
+
+func comptimeErrorPattern(code string, pattern string) string {
+	return fmt.Sprintf(`$stacktrace: error %s: %s.*`, code, pattern)
+}
+
+func getDefaultValueForType(sym lib.Symbol) string {
+	switch sym {
+	case csharp.BuiltinTypes.Integral.IntSymbol,
+		csharp.BuiltinTypes.Integral.ShortSymbol,
+		csharp.BuiltinTypes.Integral.ByteSymbol:
+		return "0"
+	case csharp.BuiltinTypes.Integral.LongSymbol:
+		return "0L"
+	case csharp.BuiltinTypes.FloatingPoint.DoubleSymbol:
+		return "0.0"
+	case csharp.BuiltinTypes.FloatingPoint.FloatSymbol:
+		return "0.0f"
+	case csharp.BuiltinTypes.FloatingPoint.DecimalSymbol:
+		return "0.0m"
+	case csharp.BuiltinTypes.BooleanSymbol:
+		return "false"
+	case csharp.BuiltinTypes.Integral.CharSymbol:
+		return "' '"
+	case csharp.BuiltinTypes.StringSymbol:
+		return "\"example\""
+	default:
+		return "null"
+	}
+}
+
+func getSpaceFromBeginning(doc *lib.Document, line int) string {
+	lineStr := doc.LineAt(line)
+	return lineStr[:len(lineStr)-len(strings.TrimLeftFunc(lineStr, unicode.IsSpace))]
+}
+
+// getStatementNode returns the nearest statement that contains the node
+func getStatementNode(node lib.SyntaxNode) lib.SyntaxNode {
+	current := node
+	for !current.IsNull() && !strings.HasSuffix(current.Type(), "_statement") {
+		current = current.Parent()
+	}
+	if current.IsNull() {
+		return node
+	}
+	return current
+}
@@ -0,0 +1,15 @@
+package csharp_test
+
+import (
+	"testing"
+
+	"github.com/nedpals/errgoengine/error_templates/csharp"
+	testutils "github.com/nedpals/errgoengine/error_templates/test_utils"
+)
+
+func TestCSharpErrorTemplates(t *testing.T) {
+	testutils.SetupTest(t, testutils.SetupTestConfig{
+		DirName:        "csharp",
+		TemplateLoader: csharp.LoadErrorTemplates,
+	}).Execute(t)
+}
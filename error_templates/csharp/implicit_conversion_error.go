@@ -0,0 +1,89 @@
+package csharp
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type implicitConversionErrorCtx struct {
+	valueNode lib.SyntaxNode
+	typeNode  lib.SyntaxNode
+}
+
+var ImplicitConversionError = lib.ErrorTemplate{
+	Name:              "ImplicitConversionError",
+	Pattern:           comptimeErrorPattern("CS0029", `Cannot implicitly convert type '(?P<currentType>[^']+)' to '(?P<expectedType>[^']+)'`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := implicitConversionErrorCtx{}
+
+		for q := m.Nearest.Query(`[
+			(variable_declaration
+				type: (_) @type
+				(variable_declarator (equals_value_clause (_) @value)))
+			(assignment_expression right: (_) @value)
+			(return_statement (_) @value)
+		]`); q.Next(); {
+			node := q.CurrentNode()
+			if q.CurrentTagName() == "type" {
+				ctx.typeNode = node
+				continue
+			}
+
+			ctx.valueNode = node
+			m.Nearest = node
+			break
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		gen.Add(
+			"This error occurs when you try to use a value of type `%s` where a `%s` is expected, and C# cannot convert it automatically.",
+			cd.Variables["currentType"],
+			cd.Variables["expectedType"],
+		)
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(implicitConversionErrorCtx)
+		if ctx.valueNode.IsNull() {
+			return
+		}
+
+		if converted := convertValue(ctx.valueNode.Text(), cd.Variables["currentType"], cd.Variables["expectedType"]); len(converted) != 0 {
+			gen.Add("Convert the value", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Convert the value from `%s` to `%s` explicitly.", cd.Variables["currentType"], cd.Variables["expectedType"]).
+					AddFix(lib.FixSuggestion{
+						NewText:       converted,
+						StartPosition: ctx.valueNode.StartPosition(),
+						EndPosition:   ctx.valueNode.EndPosition(),
+					})
+			})
+		}
+
+		if !ctx.typeNode.IsNull() {
+			gen.Add("Change the variable type", func(s *lib.BugFixSuggestion) {
+				s.AddStep("If the variable is meant to hold a `%s`, change its type to `%s`.", cd.Variables["currentType"], cd.Variables["currentType"]).
+					AddFix(lib.FixSuggestion{
+						NewText:       cd.Variables["currentType"],
+						StartPosition: ctx.typeNode.StartPosition(),
+						EndPosition:   ctx.typeNode.EndPosition(),
+					})
+			})
+		}
+	},
+}
+
+func convertValue(value string, from string, to string) string {
+	switch to {
+	case "string":
+		return fmt.Sprintf("%s.ToString()", value)
+	case "int", "long", "double", "float", "decimal", "bool":
+		if from == "string" {
+			return fmt.Sprintf("%s.Parse(%s)", to, value)
+		}
+		return fmt.Sprintf("(%s)%s", to, value)
+	}
+	return ""
+}
@@ -0,0 +1,91 @@
+package csharp
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/languages/csharp"
+)
+
+type indexOutOfRangeExceptionCtx struct {
+	arrayName string
+	length    int
+}
+
+var IndexOutOfRangeException = lib.ErrorTemplate{
+	Name:    "IndexOutOfRangeException",
+	Pattern: runtimeErrorPattern("System.IndexOutOfRangeException", `Index was outside the bounds of the array\.`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := indexOutOfRangeExceptionCtx{length: -1}
+
+		for q := m.Nearest.Query(`(element_access_expression
+			expression: (_) @array
+			subscript: (bracketed_argument_list (argument (_) @index)))`); q.Next(); {
+			node := q.CurrentNode()
+			if q.CurrentTagName() == "array" {
+				ctx.arrayName = node.Text()
+
+				// get the length of the array if it is known
+				sym := lib.UnwrapActualReturnType(cd.FindSymbol(node.Text(), node.StartPosition().Index))
+				if aSym, ok := sym.(csharp.ArraySymbol); ok && aSym.IsFixed() {
+					ctx.length = aSym.Length
+				}
+				continue
+			}
+
+			m.Nearest = node
+			break
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(indexOutOfRangeExceptionCtx)
+		if ctx.length != -1 {
+			gen.Add("This error occurs because the code is trying to access index `%s` of `%s`, which only has %d items. Array indexes start at 0, so the last valid index is %d.", cd.MainError.Nearest.Text(), ctx.arrayName, ctx.length, ctx.length-1)
+			return
+		}
+
+		gen.Add("This error occurs because the code is trying to access an index that is outside the bounds of the array. Array indexes start at 0 and end at the array's length minus one.")
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(indexOutOfRangeExceptionCtx)
+		if len(ctx.arrayName) == 0 {
+			return
+		}
+
+		gen.Add("Access an index within bounds", func(s *lib.BugFixSuggestion) {
+			newIndex := fmt.Sprintf("%s.Length - 1", ctx.arrayName)
+			if ctx.length > 0 {
+				newIndex = fmt.Sprintf("%d", ctx.length-1)
+			}
+
+			s.AddStep("Change the index to a valid position within the array, such as `%s`.", newIndex).
+				AddFix(lib.FixSuggestion{
+					NewText:       newIndex,
+					StartPosition: cd.MainError.Nearest.StartPosition(),
+					EndPosition:   cd.MainError.Nearest.EndPosition(),
+					Description:   "This makes sure that the index exists in the array.",
+				})
+		})
+
+		gen.Add("Check the index before accessing", func(s *lib.BugFixSuggestion) {
+			statement := getStatementNode(cd.MainError.Nearest)
+			startPos := statement.StartPosition()
+			spaces := getSpaceFromBeginning(cd.MainError.Document, startPos.Line)
+			index := cd.MainError.Nearest.Text()
+
+			s.AddStep("Make sure that the index is less than `%s.Length` before using it.", ctx.arrayName).
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("if (%s < %s.Length)\n%s{\n%s    ", index, ctx.arrayName, spaces, spaces),
+					StartPosition: startPos,
+					EndPosition:   startPos,
+				}).
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("\n%s}", spaces),
+					StartPosition: statement.EndPosition(),
+					EndPosition:   statement.EndPosition(),
+				})
+		})
+	},
+}
@@ -0,0 +1,75 @@
+package csharp
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/utils/levenshtein"
+)
+
+type nameNotFoundErrorCtx struct {
+	similarName string
+}
+
+var NameNotFoundError = lib.ErrorTemplate{
+	Name:              "NameNotFoundError",
+	Pattern:           comptimeErrorPattern("CS0103", `The name '(?P<name>[^']+)' does not exist in the current context`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := nameNotFoundErrorCtx{}
+		name := cd.Variables["name"]
+
+		for q := m.Nearest.Query(`((identifier) @name (#eq? @name "%s"))`, name); q.Next(); {
+			m.Nearest = q.CurrentNode()
+			break
+		}
+
+		// look for a similar name in the current scope (eg. typos)
+		nearestTree := cd.InitOrGetSymbolTree(cd.MainDocumentPath()).GetNearestScopedTree(m.Nearest.StartPosition().Index)
+		nearestDistance := -1
+		for _, sym := range nearestTree.FindSymbolsByClause(func(sym lib.Symbol) bool {
+			return sym.Kind() == lib.SymbolKindVariable
+		}) {
+			distance := levenshtein.ComputeDistance(name, sym.Name())
+			if distance > 2 || (nearestDistance != -1 && distance >= nearestDistance) {
+				continue
+			}
+
+			ctx.similarName = sym.Name()
+			nearestDistance = distance
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		gen.Add("This error occurs when you use a name (`%s`) that has not been declared in the current scope.", cd.Variables["name"])
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(nameNotFoundErrorCtx)
+
+		if len(ctx.similarName) != 0 {
+			gen.Add("Use the correct name", func(s *lib.BugFixSuggestion) {
+				s.AddStep("There is a variable named `%s` which is similar to `%s`. Check if you misspelled the name.", ctx.similarName, cd.Variables["name"]).
+					AddFix(lib.FixSuggestion{
+						NewText:       ctx.similarName,
+						StartPosition: cd.MainError.Nearest.StartPosition(),
+						EndPosition:   cd.MainError.Nearest.EndPosition(),
+					})
+			})
+		}
+
+		gen.Add("Declare the variable before using it", func(s *lib.BugFixSuggestion) {
+			statement := getStatementNode(cd.MainError.Nearest)
+			startPos := statement.StartPosition()
+			spaces := getSpaceFromBeginning(cd.MainError.Document, startPos.Line)
+
+			s.AddStep("Make sure to declare the variable `%s` before using it.", cd.Variables["name"]).
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("int %s = 0;\n%s", cd.Variables["name"], spaces),
+					StartPosition: startPos,
+					EndPosition:   startPos,
+					Description:   "Replace `int` and `0` with the type and value that you need.",
+				})
+		})
+	},
+}
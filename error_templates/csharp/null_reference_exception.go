@@ -0,0 +1,111 @@
+package csharp
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/languages/csharp"
+)
+
+type nullReferenceExceptionCtx struct {
+	origin     string
+	memberName string
+	statement  lib.SyntaxNode
+}
+
+var NullReferenceException = lib.ErrorTemplate{
+	Name:    "NullReferenceException",
+	Pattern: runtimeErrorPattern("System.NullReferenceException", `Object reference not set to an instance of an object\.`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := nullReferenceExceptionCtx{}
+
+		for q := m.Nearest.Query(`([
+			(member_access_expression expression: (identifier) @ident name: (identifier) @member)
+			(element_access_expression expression: (identifier) @ident)
+		])`); q.Next(); {
+			if q.CurrentTagName() != "ident" {
+				continue
+			}
+
+			node := q.CurrentNode()
+			retType := lib.UnwrapActualReturnType(cd.FindSymbol(node.Text(), node.StartPosition().Index))
+			if retType != csharp.BuiltinTypes.NullSymbol {
+				continue
+			}
+
+			ctx.origin = node.Text()
+			if member := node.Parent().ChildByFieldName("name"); !member.IsNull() {
+				ctx.memberName = member.Text()
+			}
+
+			m.Nearest = node
+			break
+		}
+
+		ctx.statement = getStatementNode(m.Nearest)
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(nullReferenceExceptionCtx)
+
+		if len(ctx.origin) != 0 && len(ctx.memberName) != 0 {
+			gen.Add("The error occurs because your program tried to use `%s` from `%s`, which is `null`.", ctx.memberName, ctx.origin)
+			return
+		}
+
+		gen.Add("This error occurs when your program tries to use an object reference that is `null`, meaning it doesn't point to any actual object in memory. This usually happens when a variable was never given a value or was explicitly set to `null`.")
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(nullReferenceExceptionCtx)
+		if len(ctx.origin) == 0 {
+			return
+		}
+
+		if ctx.statement.Type() == "expression_statement" {
+			gen.Add("Wrap with an if statement", func(s *lib.BugFixSuggestion) {
+				startPos := ctx.statement.StartPosition()
+				spaces := getSpaceFromBeginning(cd.MainError.Document, startPos.Line)
+
+				s.AddStep("Check if `%s` is not `null` before using it.", ctx.origin).
+					AddFix(lib.FixSuggestion{
+						NewText:       fmt.Sprintf("if (%s != null)\n%s{\n%s    ", ctx.origin, spaces, spaces),
+						StartPosition: startPos,
+						EndPosition:   startPos,
+					}).
+					AddFix(lib.FixSuggestion{
+						NewText:       fmt.Sprintf("\n%s}", spaces),
+						StartPosition: ctx.statement.EndPosition(),
+						EndPosition:   ctx.statement.EndPosition(),
+					})
+			})
+		}
+
+		// the variable is only initialized if its declaration is found
+		varSym := cd.FindSymbol(ctx.origin, cd.MainError.Nearest.StartPosition().Index)
+		if varSym == nil {
+			return
+		}
+
+		loc := varSym.Location()
+		declNode := cd.MainError.Document.RootNode().NamedDescendantForPointRange(loc)
+		if declNode.Type() != "variable_declarator" {
+			return
+		}
+
+		for i := 0; i < int(declNode.NamedChildCount()); i++ {
+			if child := declNode.NamedChild(i); child.Type() == "equals_value_clause" {
+				loc = child.NamedChild(0).Location()
+				break
+			}
+		}
+
+		gen.Add("Initialize the variable", func(s *lib.BugFixSuggestion) {
+			s.AddStep("An alternative fix is to initialize the `%s` variable with a non-null value before using it.", ctx.origin).
+				AddFix(lib.FixSuggestion{
+					NewText:       getDefaultValueForType(lib.UnwrapReturnType(varSym)),
+					StartPosition: loc.StartPos,
+					EndPosition:   loc.EndPos,
+				})
+		})
+	},
+}
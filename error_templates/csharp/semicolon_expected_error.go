@@ -0,0 +1,40 @@
+package csharp
+
+import (
+	lib "github.com/nedpals/errgoengine"
+)
+
+var SemicolonExpectedError = lib.ErrorTemplate{
+	Name:              "SemicolonExpectedError",
+	Pattern:           comptimeErrorPattern("CS1002", `; expected`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		// the compiler reports the exact position where the semicolon is
+		// expected which is right after the last token of the statement
+		pos := lib.Position{Line: m.ErrorNode.StartPos.Line - 1, Column: m.ErrorNode.StartPos.Column}
+		m.Context = pos
+
+		lastTokenPos := lib.Position{Line: pos.Line, Column: max(pos.Column-1, 0)}
+		if node := m.Document.RootNode().NamedDescendantForPointRange(lib.Location{
+			StartPos: lastTokenPos,
+			EndPos:   lastTokenPos,
+		}); !node.IsNull() {
+			m.Nearest = node
+		}
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		gen.Add("This error occurs when a statement is not terminated with a semicolon (`;`). In C#, every statement must end with a semicolon.")
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		pos := cd.MainError.Context.(lib.Position)
+
+		gen.Add("Add the missing semicolon", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Add a semicolon at the end of the statement.").
+				AddFix(lib.FixSuggestion{
+					NewText:       ";",
+					StartPosition: pos,
+					EndPosition:   pos,
+				})
+		})
+	},
+}
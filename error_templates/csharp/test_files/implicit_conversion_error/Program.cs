@@ -0,0 +1,10 @@
+using System;
+
+class Program
+{
+    static void Main(string[] args)
+    {
+        int age = "25";
+        Console.WriteLine(age);
+    }
+}
@@ -0,0 +1,10 @@
+using System;
+
+class Program
+{
+    static void Main(string[] args)
+    {
+        int[] numbers = new int[3];
+        Console.WriteLine(numbers[3]);
+    }
+}
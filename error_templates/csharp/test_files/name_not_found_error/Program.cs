@@ -0,0 +1,10 @@
+using System;
+
+class Program
+{
+    static void Main(string[] args)
+    {
+        int total = 10;
+        Console.WriteLine(totl);
+    }
+}
@@ -0,0 +1,10 @@
+using System;
+
+class Program
+{
+    static void Main(string[] args)
+    {
+        string name = null;
+        Console.WriteLine(name.Length);
+    }
+}
@@ -0,0 +1,11 @@
+using System;
+
+class Program
+{
+    static void Main(string[] args)
+    {
+        string name = "Ana";
+        name = null;
+        Console.WriteLine(name.Length);
+    }
+}
@@ -0,0 +1,10 @@
+using System;
+
+class Program
+{
+    static void Main(string[] args)
+    {
+        int count = 5
+        Console.WriteLine(count);
+    }
+}
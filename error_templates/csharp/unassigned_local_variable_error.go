@@ -0,0 +1,77 @@
+package csharp
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type unassignedLocalVariableErrorCtx struct {
+	declarationSym  lib.Symbol
+	declarationNode lib.SyntaxNode
+}
+
+var UnassignedLocalVariableError = lib.ErrorTemplate{
+	Name:              "UnassignedLocalVariableError",
+	Pattern:           comptimeErrorPattern("CS0165", `Use of unassigned local variable '(?P<variable>[^']+)'`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := unassignedLocalVariableErrorCtx{}
+
+		for q := m.Nearest.Query(`((identifier) @variable (#eq? @variable "%s"))`, cd.Variables["variable"]); q.Next(); {
+			m.Nearest = q.CurrentNode()
+			break
+		}
+
+		// get symbol and declaration node
+		nearestTree := cd.InitOrGetSymbolTree(cd.MainDocumentPath()).GetNearestScopedTree(m.Nearest.StartPosition().Index)
+		ctx.declarationSym = nearestTree.GetSymbolByNode(m.Nearest)
+		if ctx.declarationSym != nil {
+			declNode := m.Document.RootNode().NamedDescendantForPointRange(ctx.declarationSym.Location())
+			if declNode.Type() == "identifier" {
+				// declarators without a value share the same range as their name
+				declNode = declNode.Parent()
+			}
+
+			if declNode.Type() == "variable_declarator" {
+				ctx.declarationNode = declNode
+			}
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		gen.Add("This error occurs when you try to use a local variable (`%s`) that has not been given a value yet.", cd.Variables["variable"])
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(unassignedLocalVariableErrorCtx)
+		if ctx.declarationNode.IsNull() {
+			return
+		}
+
+		defaultValue := getDefaultValueForType(lib.UnwrapReturnType(ctx.declarationSym))
+
+		gen.Add("Initialize the variable", func(s *lib.BugFixSuggestion) {
+			s.AddStep("To resolve the error, initialize the `%s` variable with a value when declaring it.", cd.Variables["variable"]).
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf(" = %s", defaultValue),
+					StartPosition: ctx.declarationNode.EndPosition(),
+					EndPosition:   ctx.declarationNode.EndPosition(),
+					Description:   "This ensures that the variable has a valid initial value before it's used.",
+				})
+		})
+
+		gen.Add("Assign a value before using", func(s *lib.BugFixSuggestion) {
+			statement := getStatementNode(ctx.declarationNode)
+			spaces := getSpaceFromBeginning(cd.MainError.Document, statement.StartPosition().Line)
+
+			s.AddStep("Alternatively, you can assign a value to the variable before using it.").
+				AddFix(lib.FixSuggestion{
+					NewText:       "\n" + spaces + fmt.Sprintf("%s = %s;", cd.Variables["variable"], defaultValue),
+					StartPosition: statement.EndPosition(),
+					EndPosition:   statement.EndPosition(),
+					Description:   "This way, the variable has a value before it's used in the statement.",
+				})
+		})
+	},
+}
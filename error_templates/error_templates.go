@@ -2,11 +2,21 @@ package error_templates
 
 import (
 	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/error_templates/csharp"
 	"github.com/nedpals/errgoengine/error_templates/java"
+	"github.com/nedpals/errgoengine/error_templates/kotlin"
+	"github.com/nedpals/errgoengine/error_templates/php"
 	"github.com/nedpals/errgoengine/error_templates/python"
+	"github.com/nedpals/errgoengine/error_templates/ruby"
+	"github.com/nedpals/errgoengine/error_templates/rust"
 )
 
 func LoadErrorTemplates(errorTemplates *lib.ErrorTemplates) {
 	java.LoadErrorTemplates(errorTemplates)
 	python.LoadErrorTemplates(errorTemplates)
+	csharp.LoadErrorTemplates(errorTemplates)
+	kotlin.LoadErrorTemplates(errorTemplates)
+	ruby.LoadErrorTemplates(errorTemplates)
+	php.LoadErrorTemplates(errorTemplates)
+	rust.LoadErrorTemplates(errorTemplates)
 }
@@ -0,0 +1,175 @@
+package java
+
+import (
+	"fmt"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type jdkAbstractType struct {
+	isInterface bool
+	// the class in java.util which is commonly used instead
+	concreteClass string
+}
+
+// the interfaces and abstract classes from java.util which are commonly instantiated by mistake
+var jdkAbstractTypes = map[string]jdkAbstractType{
+	"Collection":   {isInterface: true, concreteClass: "ArrayList"},
+	"List":         {isInterface: true, concreteClass: "ArrayList"},
+	"Set":          {isInterface: true, concreteClass: "HashSet"},
+	"SortedSet":    {isInterface: true, concreteClass: "TreeSet"},
+	"Map":          {isInterface: true, concreteClass: "HashMap"},
+	"SortedMap":    {isInterface: true, concreteClass: "TreeMap"},
+	"Queue":        {isInterface: true, concreteClass: "LinkedList"},
+	"Deque":        {isInterface: true, concreteClass: "ArrayDeque"},
+	"AbstractList": {concreteClass: "ArrayList"},
+	"AbstractSet":  {concreteClass: "HashSet"},
+	"AbstractMap":  {concreteClass: "HashMap"},
+}
+
+type abstractInstantiationErrorCtx struct {
+	creation    lib.SyntaxNode
+	declaration lib.SyntaxNode
+	// the first class in the document which extends or implements the abstract class
+	subclass string
+}
+
+var AbstractInstantiationError = lib.ErrorTemplate{
+	Name:              "AbstractInstantiationError",
+	Pattern:           comptimeErrorPattern(`(?P<class>\S+) is abstract; cannot be instantiated`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		aCtx := abstractInstantiationErrorCtx{}
+		className := simpleClassName(cd.Variables["class"])
+
+		aCtx.creation = nodeOnErrorLine(m, "creation", `(object_creation_expression type: (_) @type) @creation`)
+		if !aCtx.creation.IsNull() {
+			m.Nearest = aCtx.creation
+		}
+
+		aCtx.declaration = findTypeDeclaration(m.Document, cd.InitOrGetSymbolTree(cd.MainDocumentPath()), className)
+
+		// look for a concrete class which can be instantiated instead
+		for q := m.Document.RootNode().Query(`(class_declaration name: (identifier) @name) @class`); q.Next(); {
+			if q.CurrentTagName() != "class" {
+				continue
+			}
+
+			node := q.CurrentNode()
+			if hasModifier(node, "abstract") {
+				continue
+			}
+
+			for _, supertype := range supertypesOf(node) {
+				if supertype == className {
+					aCtx.subclass = node.ChildByFieldName("name").Text()
+					break
+				}
+			}
+
+			if len(aCtx.subclass) != 0 {
+				break
+			}
+		}
+
+		m.Context = aCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(abstractInstantiationErrorCtx)
+		className := simpleClassName(cd.Variables["class"])
+
+		isInterface := jdkAbstractTypes[className].isInterface
+		if !ctx.declaration.IsNull() {
+			isInterface = ctx.declaration.Type() == "interface_declaration"
+		}
+
+		if isInterface {
+			gen.Add("This error occurs when trying to create an object directly from an interface. `%s` is an interface which only describes the methods that a class should have, so it needs a class that implements it.", className)
+			return
+		}
+
+		gen.Add("This error occurs when trying to create an object directly from an abstract class. `%s` is declared as `abstract`, so only its subclasses can be instantiated.", className)
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(abstractInstantiationErrorCtx)
+		if ctx.creation.IsNull() {
+			return
+		}
+
+		className := simpleClassName(cd.Variables["class"])
+		typeNode := ctx.creation.ChildByFieldName("type")
+
+		if len(ctx.subclass) != 0 {
+			gen.Add(fmt.Sprintf("Create an instance of %s instead", ctx.subclass), func(s *lib.BugFixSuggestion) {
+				s.AddStep("Use `%s` which is a subclass of `%s` that can be instantiated.", ctx.subclass, className).
+					AddFix(lib.FixSuggestion{
+						NewText:       ctx.subclass,
+						StartPosition: typeNode.StartPosition(),
+						EndPosition:   typeNode.EndPosition(),
+					})
+			})
+		}
+
+		if ctx.declaration.IsNull() {
+			// types from the JDK are not declared in the document
+			if jdkType, ok := jdkAbstractTypes[className]; ok && len(ctx.subclass) == 0 {
+				gen.Add(fmt.Sprintf("Create an instance of %s instead", jdkType.concreteClass), func(s *lib.BugFixSuggestion) {
+					// keep the type arguments (eg. `List<>`)
+					nameNode := typeNode
+					if nameNode.Type() == "generic_type" {
+						nameNode = nameNode.NamedChild(0)
+					}
+
+					s.AddStep("Use `%s` which is a class from `java.util` that implements `%s`.", jdkType.concreteClass, className).
+						AddFix(lib.FixSuggestion{
+							NewText:       jdkType.concreteClass,
+							StartPosition: nameNode.StartPosition(),
+							EndPosition:   nameNode.EndPosition(),
+						})
+
+					addImportStep(s, cd.MainError.Document, "java.util", jdkType.concreteClass)
+				})
+			}
+			return
+		}
+
+		abstractMethods := abstractMethodsOf(ctx.declaration)
+		if ctx.declaration.Type() == "class_declaration" && len(abstractMethods) == 0 {
+			gen.Add("Remove the abstract modifier", func(s *lib.BugFixSuggestion) {
+				abstractModifier := childOfType(childOfType(ctx.declaration, "modifiers"), "abstract")
+				endPos := abstractModifier.EndPosition()
+				endPos.Column++
+				endPos.Index++
+
+				s.AddStep("`%s` does not have any abstract methods, so it can be declared as a regular class instead.", className).
+					AddFix(lib.FixSuggestion{
+						NewText:       "",
+						StartPosition: abstractModifier.StartPosition(),
+						EndPosition:   endPos,
+					})
+			})
+			return
+		}
+
+		gen.Add("Create an anonymous class", func(s *lib.BugFixSuggestion) {
+			arguments := ctx.creation.ChildByFieldName("arguments")
+			startPos := ctx.creation.StartPosition()
+			spaces := getSpaceFromBeginning(cd.MainError.Document, startPos.Line, startPos.Column)
+			indent := spaces + getIndent(spaces, 1)
+
+			stubs := make([]string, len(abstractMethods))
+			for i, method := range abstractMethods {
+				stubs[i] = methodStub(cd, method, ctx.declaration.Type() == "interface_declaration", indent)
+			}
+
+			s.AddStep("Provide the implementation of the abstract methods of `%s` right where the object is created.", className).
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf(" {\n%s\n%s}", strings.Join(stubs, "\n\n"), spaces),
+					StartPosition: arguments.EndPosition(),
+					EndPosition:   arguments.EndPosition(),
+					Description:   "Replace the returned values with the actual implementation of each method.",
+				})
+		})
+	},
+}
@@ -1,6 +1,8 @@
 package java
 
 import (
+	"fmt"
+
 	lib "github.com/nedpals/errgoengine"
 )
 
@@ -10,10 +12,16 @@ const (
 	unknown               arithExceptionKind = 0
 	dividedByZero         arithExceptionKind = iota
 	nonTerminatingDecimal arithExceptionKind = iota
+	integerOverflow       arithExceptionKind = iota
 )
 
 type arithExceptionCtx struct {
 	kind arithExceptionKind
+	// the divisor which became zero after a multiplication overflowed
+	overflow    intOverflow
+	hasOverflow bool
+	// the `Math.*Exact` method call which detected the overflow
+	exactCall lib.SyntaxNode
 }
 
 var ArithmeticException = lib.ErrorTemplate{
@@ -31,15 +39,41 @@ var ArithmeticException = lib.ErrorTemplate{
 		case "Non-terminating decimal expansion; no exact representable decimal result.":
 			ctx.kind = nonTerminatingDecimal
 			query = "((method_invocation) @methodCall (#eq? @methodCall \".divide\"))"
+		case "integer overflow", "long overflow":
+			ctx.kind = integerOverflow
+			query = `((method_invocation object: (identifier) @object name: (identifier) @name) @call (#eq? @object "Math") (#match? @name "Exact$"))`
 		default:
 			ctx.kind = unknown
 		}
 
-		if len(query) != 0 {
+		if ctx.kind == integerOverflow {
+			for q := err.Nearest.Query(query); q.Next(); {
+				if q.CurrentTagName() == "call" {
+					ctx.exactCall = q.CurrentNode()
+					err.Nearest = ctx.exactCall
+					break
+				}
+			}
+		} else if len(query) != 0 {
+			found := false
 			for q := err.Nearest.Query(query); q.Next(); {
 				err.Nearest = q.CurrentNode()
+				found = true
 				break
 			}
+
+			// the divisor may be a variable whose multiplication overflowed into zero
+			if !found && ctx.kind == dividedByZero {
+				for q := err.Nearest.Query(`(binary_expression operator: ["/" "%s"] right: (_) @divisor)`, "%"); q.Next(); {
+					divisor := q.CurrentNode()
+					if overflow, ok := findIntOverflow(cd, err.Document, divisor); ok && overflow.wrapped == 0 {
+						ctx.overflow = overflow
+						ctx.hasOverflow = true
+						err.Nearest = divisor
+						break
+					}
+				}
+			}
 		}
 
 		err.Context = ctx
@@ -49,8 +83,29 @@ var ArithmeticException = lib.ErrorTemplate{
 		switch ctx.kind {
 		case dividedByZero:
 			gen.Add("This error is raised when you try to perform arithmetic operations that are not mathematically possible, such as division by zero.")
+			if ctx.hasOverflow {
+				gen.Add(
+					" `%s` is 0 because `%s` is %s, which is too large for an `int` and wraps around to 0.",
+					cd.MainError.Nearest.Text(), ctx.overflow.expression.Text(), ctx.overflow.value,
+				)
+			}
 		case nonTerminatingDecimal:
 			gen.Add("This error is raised when dividing two `BigDecimal` numbers, and the division operation results in a non-terminating decimal expansion, meaning the division produces a non-repeating and non-terminating decimal.")
+		case integerOverflow:
+			typeName, maxValue := "an `int`", "2147483647"
+			if cd.Variables["reason"] == "long overflow" {
+				typeName, maxValue = "a `long`", "9223372036854775807"
+			}
+
+			if ctx.exactCall.IsNull() {
+				gen.Add("This error is raised when the result of a calculation is too large to fit in %s, which can only hold values up to %s.", typeName, maxValue)
+				return
+			}
+
+			gen.Add(
+				"This error is raised by `%s` when its result is too large to fit in %s, which can only hold values up to %s.",
+				ctx.exactCall.ChildByFieldName("name").Text(), typeName, maxValue,
+			)
 		case unknown:
 			gen.Add("You just encountered an unknown `ArithmeticException` error of which we cannot explain to you properly.")
 		}
@@ -59,6 +114,11 @@ var ArithmeticException = lib.ErrorTemplate{
 		ctx := cd.MainError.Context.(arithExceptionCtx)
 		switch ctx.kind {
 		case dividedByZero:
+			if ctx.hasOverflow && !ctx.overflow.declaration.IsNull() {
+				addLongDeclarationFix(gen, ctx.overflow.declaration, useLongMultiplicationFix(ctx.overflow))
+				return
+			}
+
 			gen.Add("Avoid dividing by zero.", func(s *lib.BugFixSuggestion) {
 				s.AddStep("To fix the 'ArithmeticException: / by zero', you need to ensure you are not dividing by zero, which is mathematically undefined.").
 					AddFix(lib.FixSuggestion{
@@ -68,6 +128,26 @@ var ArithmeticException = lib.ErrorTemplate{
 						EndPosition:   cd.MainError.Nearest.EndPosition(),
 					})
 			})
+		case integerOverflow:
+			if ctx.exactCall.IsNull() || cd.Variables["reason"] != "integer overflow" {
+				return
+			}
+
+			name := ctx.exactCall.ChildByFieldName("name").Text()
+			arguments := ctx.exactCall.ChildByFieldName("arguments")
+			declarator := ctx.exactCall.Parent()
+			if arguments.NamedChildCount() != 2 || declarator.Type() != "variable_declarator" {
+				return
+			}
+
+			// the `long` version of the method is used once one of the arguments is a `long`
+			firstArg := arguments.NamedChild(0)
+			addLongDeclarationFix(gen, declarator.Parent(), lib.FixSuggestion{
+				NewText:       "(long) ",
+				StartPosition: firstArg.StartPosition(),
+				EndPosition:   firstArg.StartPosition(),
+				Description:   fmt.Sprintf("Casting `%s` to `long` makes `%s` compute the result as a `long`.", firstArg.Text(), name),
+			})
 		case nonTerminatingDecimal:
 			gen.Add("Ensure precise division", func(s *lib.BugFixSuggestion) {
 				s.AddStep("To fix the 'ArithmeticException: Non-terminating decimal expansion', you need to ensure the division operation is precise.").
@@ -99,3 +179,22 @@ var ArithmeticException = lib.ErrorTemplate{
 		}
 	},
 }
+
+// addLongDeclarationFix suggests changing the type of the `int` declaration to
+// `long` along with the fix which makes its value a `long`
+func addLongDeclarationFix(gen *lib.BugFixGenerator, declaration lib.SyntaxNode, valueFix lib.FixSuggestion) {
+	typeNode := declaration.ChildByFieldName("type")
+	if typeNode.Text() != "int" {
+		return
+	}
+
+	gen.Add("Use long instead of int", func(s *lib.BugFixSuggestion) {
+		s.AddStep("Change the type of `%s` to `long` and make its value a `long` so that it fits.", declaration.ChildByFieldName("declarator").ChildByFieldName("name").Text()).
+			AddFix(lib.FixSuggestion{
+				NewText:       "long",
+				StartPosition: typeNode.StartPosition(),
+				EndPosition:   typeNode.EndPosition(),
+			}).
+			AddFix(valueFix)
+	})
+}
@@ -0,0 +1,181 @@
+package java
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type jumpOutsideLoopErrorCtx struct {
+	statement lib.SyntaxNode
+	// the lambda expression, method, constructor or initializer block containing the statement
+	enclosing lib.SyntaxNode
+}
+
+var BreakOutsideSwitchOrLoopError = lib.ErrorTemplate{
+	Name:              "BreakOutsideSwitchOrLoopError",
+	Pattern:           comptimeErrorPattern(`break outside switch or loop`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		m.Context = analyzeJumpOutsideLoop(m, "break_statement")
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(jumpOutsideLoopErrorCtx)
+		gen.Add("This error occurs when `break` is used outside of a loop or a `switch` statement, where there is nothing for it to stop.")
+		explainJumpOutsideLoop(gen, ctx, "break")
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(jumpOutsideLoopErrorCtx)
+		if ctx.statement.IsNull() || ctx.enclosing.IsNull() {
+			return
+		}
+
+		if ctx.enclosing.Type() == "lambda_expression" {
+			addForEachLoopFix(cd, gen, ctx.enclosing, "break")
+			return
+		}
+
+		// returning without a value is only possible in void methods and constructors
+		canReturn := ctx.enclosing.Type() == "constructor_declaration"
+		if ctx.enclosing.Type() == "method_declaration" {
+			canReturn = ctx.enclosing.ChildByFieldName("type").Type() == "void_type"
+		}
+
+		if canReturn {
+			gen.Add("Use return to exit the method", func(s *lib.BugFixSuggestion) {
+				keyword := ctx.statement.Child(0)
+				s.AddStep("If the intention is to stop the rest of %s from running, use `return` instead of `break`.", describeEnclosingBody(ctx.enclosing)).
+					AddFix(lib.FixSuggestion{
+						NewText:       "return",
+						StartPosition: keyword.StartPosition(),
+						EndPosition:   keyword.EndPosition(),
+					})
+			})
+		}
+
+		gen.Add("Remove the break statement", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Remove the `break` since there is no loop or `switch` to stop.").
+				AddFix(removeLineFix(cd.MainError.Document, ctx.statement))
+		})
+	},
+}
+
+func analyzeJumpOutsideLoop(m *lib.MainError, statementType string) jumpOutsideLoopErrorCtx {
+	ctx := jumpOutsideLoopErrorCtx{}
+	ctx.statement = nodeOnErrorLine(m, "statement", fmt.Sprintf("(%s) @statement", statementType))
+	if ctx.statement.IsNull() {
+		return ctx
+	}
+
+	m.Nearest = ctx.statement
+	ctx.enclosing = enclosingBody(ctx.statement)
+	return ctx
+}
+
+func explainJumpOutsideLoop(gen *lib.ExplainGenerator, ctx jumpOutsideLoopErrorCtx, keyword string) {
+	if ctx.enclosing.IsNull() {
+		return
+	}
+
+	if ctx.enclosing.Type() == "lambda_expression" {
+		gen.Add(" The `%s` is inside %s, which is a separate method called for each element instead of a loop, so `%s` cannot be used there.", keyword, describeEnclosingBody(ctx.enclosing), keyword)
+		return
+	}
+
+	gen.Add(" The `%s` is inside %s but it is not part of any loop.", keyword, describeEnclosingBody(ctx.enclosing))
+}
+
+// enclosingBody returns the nearest lambda expression, method, constructor or
+// initializer block containing the node
+func enclosingBody(node lib.SyntaxNode) lib.SyntaxNode {
+	for parent := node.Parent(); !parent.IsNull(); parent = parent.Parent() {
+		switch parent.Type() {
+		case "lambda_expression", "method_declaration", "constructor_declaration", "static_initializer":
+			return parent
+		case "block":
+			if parent.Parent().Type() == "class_body" {
+				return parent
+			}
+		}
+	}
+	return lib.SyntaxNode{}
+}
+
+func describeEnclosingBody(node lib.SyntaxNode) string {
+	switch node.Type() {
+	case "lambda_expression":
+		if invocation := node.Parent().Parent(); invocation.Type() == "method_invocation" {
+			return fmt.Sprintf("the lambda expression passed to `%s`", invocation.ChildByFieldName("name").Text())
+		}
+		return "a lambda expression"
+	case "method_declaration":
+		return fmt.Sprintf("the `%s` method", node.ChildByFieldName("name").Text())
+	case "constructor_declaration":
+		return fmt.Sprintf("the constructor of `%s`", node.ChildByFieldName("name").Text())
+	default:
+		return "an initializer block"
+	}
+}
+
+// removeLineFix removes the line of the statement
+func removeLineFix(doc *lib.Document, statement lib.SyntaxNode) lib.FixSuggestion {
+	line := statement.StartPosition().Line
+	return lib.FixSuggestion{
+		NewText:       "",
+		StartPosition: lib.Position{Line: line},
+		EndPosition:   lib.Position{Line: line, Column: len(doc.LineAt(line))},
+	}
+}
+
+// addForEachLoopFix suggests replacing the `forEach` call of the lambda with a
+// for-each loop where the statement can be used
+func addForEachLoopFix(cd *lib.ContextData, gen *lib.BugFixGenerator, lambda lib.SyntaxNode, keyword string) {
+	invocation := lambda.Parent().Parent()
+	if invocation.Type() != "method_invocation" || invocation.ChildByFieldName("name").Text() != "forEach" {
+		return
+	}
+
+	statement := invocation.Parent()
+	body := lambda.ChildByFieldName("body")
+	receiver := invocation.ChildByFieldName("object")
+	if statement.Type() != "expression_statement" || body.Type() != "block" || receiver.IsNull() {
+		return
+	}
+
+	parameter := lambda.ChildByFieldName("parameters")
+	if parameter.Type() == "inferred_parameters" && parameter.NamedChildCount() == 1 {
+		parameter = parameter.NamedChild(0)
+	}
+
+	if parameter.Type() != "identifier" {
+		return
+	}
+
+	// use the type argument of the collection as the type of the element
+	elementType := "var"
+	if receiver.Type() == "identifier" {
+		if declaration := findVariableDeclaration(cd, cd.MainError.Document, invocation, receiver.Text()); !declaration.IsNull() {
+			typeArgs := childOfType(declaration.ChildByFieldName("type"), "type_arguments")
+			if !typeArgs.IsNull() && typeArgs.NamedChildCount() == 1 {
+				elementType = typeArgs.NamedChild(0).Text()
+			}
+		}
+	}
+
+	gen.Add("Use a for-each loop instead", func(s *lib.BugFixSuggestion) {
+		s.AddStep("Replace the `forEach` call with a for-each loop over `%s` where `%s` can be used.", receiver.Text(), keyword).
+			AddFix(lib.FixSuggestion{
+				NewText:       fmt.Sprintf("for (%s %s : %s) {", elementType, parameter.Text(), receiver.Text()),
+				StartPosition: statement.StartPosition(),
+				EndPosition:   body.Child(0).EndPosition(),
+			})
+
+		closingBrace := body.Child(int(body.ChildCount()) - 1)
+		s.AddStep("Close the loop with `}` instead of `});`.").
+			AddFix(lib.FixSuggestion{
+				NewText:       "}",
+				StartPosition: closingBrace.StartPosition(),
+				EndPosition:   statement.EndPosition(),
+			})
+	})
+}
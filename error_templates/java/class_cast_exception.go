@@ -0,0 +1,213 @@
+package java
+
+import (
+	"fmt"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+// superclasses of the commonly used java.lang classes
+var builtinSuperclasses = map[string]string{
+	"Integer":   "Number",
+	"Long":      "Number",
+	"Short":     "Number",
+	"Byte":      "Number",
+	"Double":    "Number",
+	"Float":     "Number",
+	"Number":    "Object",
+	"String":    "Object",
+	"Boolean":   "Object",
+	"Character": "Object",
+}
+
+var parseMethodsByClass = map[string]string{
+	"Integer": "parseInt",
+	"Long":    "parseLong",
+	"Short":   "parseShort",
+	"Byte":    "parseByte",
+	"Double":  "parseDouble",
+	"Float":   "parseFloat",
+	"Boolean": "parseBoolean",
+}
+
+type classCastExceptionCtx struct {
+	sourceClass string
+	targetClass string
+	cast        lib.SyntaxNode
+	statement   lib.SyntaxNode
+	hierarchy   string
+}
+
+var ClassCastException = lib.ErrorTemplate{
+	Name:    "ClassCastException",
+	Pattern: runtimeErrorPattern("java.lang.ClassCastException", `(?:class )?(?P<sourceClass>\S+) cannot be cast to (?:class )?(?P<targetClass>[^\s()]+)(?: \(.*\))?`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		cCtx := classCastExceptionCtx{
+			sourceClass: simpleClassName(cd.Variables["sourceClass"]),
+			targetClass: simpleClassName(cd.Variables["targetClass"]),
+		}
+
+		errorLine := m.ErrorNode.StartPos.Line - 1
+		for nearest := m.Nearest; !nearest.IsNull() && cCtx.cast.IsNull(); nearest = nearest.Parent() {
+			for q := nearest.Query(`(cast_expression type: (_) @type) @cast`); q.Next(); {
+				if q.CurrentTagName() != "cast" {
+					continue
+				}
+
+				node := q.CurrentNode()
+				if node.StartPosition().Line == errorLine && simpleClassName(node.ChildByFieldName("type").Text()) == cCtx.targetClass {
+					cCtx.cast = node
+					break
+				}
+			}
+		}
+
+		if !cCtx.cast.IsNull() {
+			m.Nearest = cCtx.cast
+			cCtx.statement = getStatementNode(cCtx.cast)
+		}
+
+		tree := cd.InitOrGetSymbolTree(cd.MainDocumentPath()).GetNearestScopedTree(m.Nearest.StartPosition().Index)
+		cCtx.hierarchy = describeClassRelationship(
+			classHierarchy(m.Document, tree, cCtx.sourceClass),
+			classHierarchy(m.Document, tree, cCtx.targetClass),
+		)
+
+		m.Context = cCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(classCastExceptionCtx)
+		gen.Add("This error occurs when an object is cast to a class that it is not an instance of. ")
+		gen.Add("The object being cast is an instance of `%s`, not `%s`. %s", ctx.sourceClass, ctx.targetClass, ctx.hierarchy)
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(classCastExceptionCtx)
+		if ctx.cast.IsNull() {
+			return
+		}
+
+		doc := cd.MainError.Document
+		valueNode := ctx.cast.ChildByFieldName("value")
+
+		if !ctx.statement.IsNull() {
+			gen.Add("Check the type with instanceof before casting", func(s *lib.BugFixSuggestion) {
+				step := s.AddStep(
+					"Use the `instanceof` operator to make sure that `%s` is a `%s` before casting it.",
+					valueNode.Text(),
+					ctx.targetClass,
+				)
+				wrapStatements(step, doc, ctx.statement, lastStatementUsingDeclaration(ctx.statement), fmt.Sprintf("if (%s instanceof %s) {", valueNode.Text(), ctx.targetClass), "}")
+				step.Fixes[len(step.Fixes)-1].Description = "This skips the cast when the object is of a different class."
+			})
+		}
+
+		// the runtime class of the value is the source class so calling
+		// toString() is safe even if the value is declared as an Object
+		value := valueNode.Text()
+		if ctx.targetClass != "String" {
+			value += ".toString()"
+		}
+
+		if conversion, ok := convertValueToClass(value, ctx.sourceClass, ctx.targetClass); ok {
+			gen.Add(fmt.Sprintf("Convert the %s value to %s", ctx.sourceClass, ctx.targetClass), func(s *lib.BugFixSuggestion) {
+				s.AddStep("If you need the value as `%s`, convert it instead of casting it.", ctx.targetClass).
+					AddFix(lib.FixSuggestion{
+						NewText:       conversion,
+						StartPosition: ctx.cast.StartPosition(),
+						EndPosition:   ctx.cast.EndPosition(),
+					})
+			})
+		}
+	},
+}
+
+func simpleClassName(name string) string {
+	if idx := strings.LastIndexAny(name, ".$"); idx != -1 {
+		return name[idx+1:]
+	}
+	return name
+}
+
+func getStatementNode(node lib.SyntaxNode) lib.SyntaxNode {
+	for current := node; !current.IsNull(); current = current.Parent() {
+		if parent := current.Parent(); parent.Type() == "block" || parent.Type() == "constructor_body" {
+			return current
+		}
+	}
+	return lib.SyntaxNode{}
+}
+
+// classHierarchy returns the class followed by its superclasses up to Object.
+// Superclasses of the classes declared in the document are taken from their
+// class declarations found in the symbol tree.
+func classHierarchy(doc *lib.Document, tree *lib.SymbolTree, className string) []string {
+	hierarchy := []string{className}
+
+	for current := className; current != "Object" && len(hierarchy) < 16; {
+		if superclass, ok := builtinSuperclasses[current]; ok {
+			current = superclass
+		} else if classNode := findClassDeclaration(doc, tree, current); !classNode.IsNull() {
+			current = "Object"
+			if superclassNode := classNode.ChildByFieldName("superclass"); !superclassNode.IsNull() {
+				current = simpleClassName(superclassNode.LastNamedChild().Text())
+			}
+		} else {
+			// unknown classes are assumed to extend Object
+			current = "Object"
+		}
+
+		hierarchy = append(hierarchy, current)
+	}
+
+	return hierarchy
+}
+
+func findClassDeclaration(doc *lib.Document, tree *lib.SymbolTree, className string) lib.SyntaxNode {
+	if sym := tree.Find(className); sym != nil && sym.Kind() == lib.SymbolKindClass {
+		return doc.RootNode().NamedDescendantForPointRange(sym.Location())
+	}
+
+	// classes with empty bodies are not included in the symbol tree
+	for q := doc.RootNode().Query(`(class_declaration name: (identifier) @name (#eq? @name "%s")) @class`, className); q.Next(); {
+		if q.CurrentTagName() == "class" {
+			return q.CurrentNode()
+		}
+	}
+
+	return lib.SyntaxNode{}
+}
+
+func describeClassRelationship(source []string, target []string) string {
+	common := "Object"
+
+sourceLoop:
+	for _, sourceClass := range source {
+		for _, targetClass := range target {
+			if sourceClass == targetClass {
+				common = sourceClass
+				break sourceLoop
+			}
+		}
+	}
+
+	switch common {
+	case source[0]:
+		return fmt.Sprintf("`%s` is a subclass of `%s`, but the object itself is not a `%s`.", target[0], source[0], target[0])
+	case target[0]:
+		return fmt.Sprintf("`%s` is a subclass of `%s`.", source[0], target[0])
+	case "Object":
+		return fmt.Sprintf("`%s` and `%s` are unrelated classes since they only share `Object` as their common superclass.", source[0], target[0])
+	default:
+		return fmt.Sprintf("`%s` and `%s` both extend `%s`, but neither of them is a subclass of the other.", source[0], target[0], common)
+	}
+}
+
+func convertValueToClass(value string, sourceClass string, targetClass string) (string, bool) {
+	if targetClass == "String" {
+		return fmt.Sprintf("String.valueOf(%s)", value), true
+	} else if parseMethod, ok := parseMethodsByClass[targetClass]; ok && sourceClass == "String" {
+		return fmt.Sprintf("%s.%s(%s)", targetClass, parseMethod, value), true
+	}
+	return "", false
+}
@@ -0,0 +1,134 @@
+package java
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type concurrentModificationExceptionCtx struct {
+	loop       lib.SyntaxNode
+	item       lib.SyntaxNode
+	collection lib.SyntaxNode
+	call       lib.SyntaxNode
+	// condition of the if statement which removes the item (eg. `name.isEmpty()`)
+	// if the loop can be replaced with removeIf
+	condition lib.SyntaxNode
+}
+
+var ConcurrentModificationException = lib.ErrorTemplate{
+	Name:    "ConcurrentModificationException",
+	Pattern: runtimeErrorPattern("java.util.ConcurrentModificationException", ""),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := concurrentModificationExceptionCtx{}
+		ctx.loop = nodeOnErrorLine(m, "loop", `(enhanced_for_statement) @loop`)
+		if ctx.loop.IsNull() {
+			m.Context = ctx
+			return
+		}
+
+		ctx.collection = ctx.loop.ChildByFieldName("value")
+		ctx.item = ctx.collection.PrevNamedSibling()
+		m.Nearest = ctx.loop
+
+		for q := ctx.loop.ChildByFieldName("body").Query(`((method_invocation
+			object: (_)
+			name: (identifier) @name) @call
+			(#match? @name "^(add|addAll|remove|removeAll|clear)$"))`); q.Next(); {
+			if q.CurrentTagName() != "call" {
+				continue
+			}
+
+			node := q.CurrentNode()
+			if node.ChildByFieldName("object").Text() == ctx.collection.Text() {
+				ctx.call = node
+				m.Nearest = node
+				break
+			}
+		}
+
+		if ctx.call.IsNull() || ctx.call.ChildByFieldName("name").Text() != "remove" {
+			m.Context = ctx
+			return
+		}
+
+		// loops which only contain `if (condition) { list.remove(item); }`
+		// can be replaced with removeIf
+		body := ctx.loop.ChildByFieldName("body")
+		if body.Type() == "block" && body.NamedChildCount() == 1 && body.FirstNamedChild().Type() == "if_statement" {
+			ifStatement := body.FirstNamedChild()
+			consequence := ifStatement.ChildByFieldName("consequence")
+			if consequence.Type() == "block" && consequence.NamedChildCount() == 1 {
+				consequence = consequence.FirstNamedChild()
+			}
+
+			if ifStatement.ChildByFieldName("alternative").IsNull() &&
+				consequence.Type() == "expression_statement" &&
+				consequence.FirstNamedChild().StartByte() == ctx.call.StartByte() &&
+				ctx.call.ChildByFieldName("arguments").Text() == fmt.Sprintf("(%s)", ctx.item.Text()) {
+				ctx.condition = ifStatement.ChildByFieldName("condition").FirstNamedChild()
+			}
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(concurrentModificationExceptionCtx)
+		gen.Add("This error occurs when a collection is modified while it is being iterated, which makes the iterator used by the loop invalid.")
+
+		if !ctx.call.IsNull() {
+			gen.Add(" The loop goes through the items of `%s`, but `%s` changes it before the loop finishes.", ctx.collection.Text(), ctx.call.Text())
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(concurrentModificationExceptionCtx)
+		if ctx.call.IsNull() || ctx.call.ChildByFieldName("name").Text() != "remove" {
+			return
+		}
+
+		doc := cd.MainError.Document
+
+		if !ctx.condition.IsNull() {
+			gen.Add("Use removeIf", func(s *lib.BugFixSuggestion) {
+				s.AddStep(
+					"Replace the loop with `removeIf` which removes all the items of `%s` that match the condition.",
+					ctx.collection.Text(),
+				).AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("%s.removeIf(%s -> %s);", ctx.collection.Text(), ctx.item.Text(), ctx.condition.Text()),
+					StartPosition: ctx.loop.StartPosition(),
+					EndPosition:   ctx.loop.EndPosition(),
+				})
+			})
+		}
+
+		gen.Add("Remove the items using an iterator", func(s *lib.BugFixSuggestion) {
+			body := ctx.loop.ChildByFieldName("body")
+			itemType := ctx.loop.ChildByFieldName("type").Text()
+			firstStatement := body.FirstNamedChild()
+			spaces := getSpaceFromBeginning(doc, firstStatement.StartPosition().Line, firstStatement.StartPosition().Column)
+
+			s.AddStep("Loop through `%s` using an `Iterator` instead.", ctx.collection.Text()).
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("for (Iterator<%s> iterator = %s.iterator(); iterator.hasNext();) ", itemType, ctx.collection.Text()),
+					StartPosition: ctx.loop.StartPosition(),
+					EndPosition:   body.StartPosition(),
+				})
+
+			s.AddStep("Remove the item using the iterator which safely removes the current item from `%s`.", ctx.collection.Text()).
+				AddFix(lib.FixSuggestion{
+					NewText:       "iterator.remove()",
+					StartPosition: ctx.call.StartPosition(),
+					EndPosition:   ctx.call.EndPosition(),
+				})
+
+			s.AddStep("Get the current item from the iterator at the start of the loop.").
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("%s %s = iterator.next();\n%s", itemType, ctx.item.Text(), spaces),
+					StartPosition: firstStatement.StartPosition(),
+					EndPosition:   firstStatement.StartPosition(),
+				})
+
+			addImportStep(s, doc, "java.util", "Iterator")
+		})
+	},
+}
@@ -0,0 +1,105 @@
+package java
+
+import (
+	lib "github.com/nedpals/errgoengine"
+)
+
+type constantExpressionRequiredErrorCtx struct {
+	label       lib.SyntaxNode
+	variable    lib.SyntaxNode
+	declaration lib.SyntaxNode
+	// the value given to the variable in its declaration
+	value lib.SyntaxNode
+}
+
+var ConstantExpressionRequiredError = lib.ErrorTemplate{
+	Name:              "ConstantExpressionRequiredError",
+	Pattern:           comptimeErrorPattern(`constant expression required`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		cCtx := constantExpressionRequiredErrorCtx{}
+		cCtx.label = nodeOnErrorLine(m, "label", `(switch_label) @label`)
+		if cCtx.label.IsNull() {
+			m.Context = cCtx
+			return
+		}
+
+		m.Nearest = cCtx.label
+		for _, value := range labelValues(cCtx.label) {
+			if value.Type() == "identifier" {
+				cCtx.variable = value
+				m.Nearest = value
+				break
+			}
+		}
+
+		if !cCtx.variable.IsNull() {
+			cCtx.declaration = findVariableDeclaration(cd, m.Document, cCtx.variable, cCtx.variable.Text())
+		}
+
+		cCtx.value = declaredValue(cCtx.declaration, cCtx.variable.Text())
+
+		m.Context = cCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(constantExpressionRequiredErrorCtx)
+		gen.Add("This error occurs when the value of a `case` is not a constant which is known before the program runs.")
+		if ctx.variable.IsNull() {
+			return
+		}
+
+		if !ctx.declaration.IsNull() && hasModifier(ctx.declaration, "final") {
+			gen.Add(" `%s` is declared as `final` but its value is only known once the program runs.", ctx.variable.Text())
+			return
+		}
+
+		gen.Add(
+			" `%s` is a variable that is not declared as `final`, so `%s` cannot use it as a case even if its value never changes.",
+			ctx.variable.Text(), switchHeader(enclosingSwitch(ctx.label)),
+		)
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(constantExpressionRequiredErrorCtx)
+		// values computed while the program runs cannot be used as a case
+		if ctx.variable.IsNull() || ctx.declaration.IsNull() || !isLiteral(ctx.value) {
+			return
+		}
+
+		variable := ctx.variable.Text()
+		if !hasModifier(ctx.declaration, "final") {
+			gen.Add("Declare the variable as final", func(s *lib.BugFixSuggestion) {
+				typePos := ctx.declaration.ChildByFieldName("type").StartPosition()
+				s.AddStep("Add `final` to the declaration of `%s` so that its value can be used as a case.", variable).
+					AddFix(lib.FixSuggestion{
+						NewText:       "final ",
+						StartPosition: typePos,
+						EndPosition:   typePos,
+					})
+			})
+		}
+
+		gen.Add("Use the value directly", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Replace `%s` with its value `%s`.", variable, ctx.value.Text()).
+				AddFix(lib.FixSuggestion{
+					NewText:       ctx.value.Text(),
+					StartPosition: ctx.variable.StartPosition(),
+					EndPosition:   ctx.variable.EndPosition(),
+				})
+		})
+	},
+}
+
+// isLiteral checks if the node is a literal value which can be used as a constant
+func isLiteral(node lib.SyntaxNode) bool {
+	if node.IsNull() {
+		return false
+	}
+
+	switch node.Type() {
+	case "decimal_integer_literal", "hex_integer_literal", "octal_integer_literal", "binary_integer_literal",
+		"decimal_floating_point_literal", "character_literal", "string_literal", "true", "false":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,190 @@
+package java
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type constructorCannotBeAppliedErrorCtx struct {
+	creation      lib.SyntaxNode
+	class         lib.SyntaxNode
+	requiredTypes []string
+	foundTypes    []string
+	// parameters of the constructor taken from the symbol tree
+	parameters []*lib.VariableSymbol
+}
+
+var ConstructorCannotBeAppliedError = lib.ErrorTemplate{
+	Name: "ConstructorCannotBeAppliedError",
+	Pattern: comptimeErrorPattern(
+		`constructor (?P<constructor>\S+) in class (?P<className>\S+) cannot be applied to given types;`,
+		`required:\s+(?P<requiredTypes>.+)\s+found:\s+(?P<foundTypes>.+)\s+reason:\s+(?P<reason>.+)`,
+	),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		cCtx := constructorCannotBeAppliedErrorCtx{
+			requiredTypes: splitTypeList(cd.Variables["requiredTypes"]),
+			foundTypes:    splitTypeList(cd.Variables["foundTypes"]),
+		}
+
+		className := simpleClassName(cd.Variables["className"])
+		cCtx.creation = nodeOnErrorLine(m, "creation", `(object_creation_expression type: (_) @type) @creation`)
+		if !cCtx.creation.IsNull() {
+			m.Nearest = cCtx.creation.ChildByFieldName("arguments")
+		}
+
+		tree := cd.InitOrGetSymbolTree(cd.MainDocumentPath())
+		cCtx.class = findClassDeclaration(m.Document, tree, className)
+
+		// the constructor is a method of the class with the same name
+		if classSym, ok := tree.Find(className).(*lib.TopLevelSymbol); ok && classSym.Children() != nil {
+			if constructorSym, ok := classSym.Children().Symbols[className].(*lib.TopLevelSymbol); ok && constructorSym.Children() != nil {
+				for _, sym := range constructorSym.Children().Symbols {
+					if paramSym, ok := sym.(*lib.VariableSymbol); ok && paramSym.IsParam() {
+						cCtx.parameters = append(cCtx.parameters, paramSym)
+					}
+				}
+
+				sort.Slice(cCtx.parameters, func(i, j int) bool {
+					return cCtx.parameters[i].Location().StartPos.Index < cCtx.parameters[j].Location().StartPos.Index
+				})
+			}
+		}
+
+		// parameters of overloaded constructors cannot be told apart
+		if len(cCtx.parameters) != len(cCtx.requiredTypes) {
+			cCtx.parameters = nil
+		}
+
+		m.Context = cCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(constructorCannotBeAppliedErrorCtx)
+		className := simpleClassName(cd.Variables["className"])
+
+		if len(ctx.requiredTypes) != len(ctx.foundTypes) {
+			gen.Add("This error occurs when an object is created with a different number of arguments than what its constructor expects. ")
+		} else {
+			gen.Add("This error occurs when an object is created with arguments whose types do not match what its constructor expects. ")
+		}
+
+		gen.Add("The constructor of `%s` requires %s, but it was given %s.", className, describeTypeList(ctx.requiredTypes), describeTypeList(ctx.foundTypes))
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(constructorCannotBeAppliedErrorCtx)
+		if ctx.creation.IsNull() {
+			return
+		}
+
+		className := simpleClassName(cd.Variables["className"])
+		arguments := ctx.creation.ChildByFieldName("arguments")
+		argsCount := int(arguments.NamedChildCount())
+
+		if argsCount < len(ctx.requiredTypes) {
+			gen.Add("Pass the missing arguments", func(s *lib.BugFixSuggestion) {
+				missing := make([]string, 0, len(ctx.requiredTypes)-argsCount)
+				names := make([]string, 0, cap(missing))
+				for i := argsCount; i < len(ctx.requiredTypes); i++ {
+					if ctx.parameters != nil {
+						missing = append(missing, getDefaultValueForType(ctx.parameters[i].ReturnType()))
+						names = append(names, fmt.Sprintf("`%s`", ctx.parameters[i].Name()))
+					} else {
+						missing = append(missing, getDefaultValueForType(cd.FindSymbol(ctx.requiredTypes[i], 0)))
+						names = append(names, fmt.Sprintf("`%s`", ctx.requiredTypes[i]))
+					}
+				}
+
+				newText := strings.Join(missing, ", ")
+				if argsCount != 0 {
+					newText = ", " + newText
+				}
+
+				// insert before the closing parenthesis
+				endPos := arguments.EndPosition()
+				endPos.Column--
+				endPos.Index--
+
+				s.AddStep("Provide the values for %s when creating the `%s` object.", strings.Join(names, ", "), className).
+					AddFix(lib.FixSuggestion{
+						NewText:       newText,
+						StartPosition: endPos,
+						EndPosition:   endPos,
+						Description:   "Replace the values with the actual values of the object.",
+					})
+			})
+		} else if argsCount > len(ctx.requiredTypes) {
+			gen.Add("Remove the extra arguments", func(s *lib.BugFixSuggestion) {
+				startPos := arguments.StartPosition()
+				startPos.Column++
+				startPos.Index++
+				if len(ctx.requiredTypes) != 0 {
+					startPos = arguments.NamedChild(len(ctx.requiredTypes) - 1).EndPosition()
+				}
+
+				s.AddStep("Pass only the arguments that the constructor of `%s` accepts.", className).
+					AddFix(lib.FixSuggestion{
+						NewText:       "",
+						StartPosition: startPos,
+						EndPosition:   arguments.LastNamedChild().EndPosition(),
+					})
+			})
+		}
+
+		if ctx.class.IsNull() || len(ctx.foundTypes) == 0 {
+			return
+		}
+
+		gen.Add("Add a constructor that accepts the arguments", func(s *lib.BugFixSuggestion) {
+			body := ctx.class.ChildByFieldName("body")
+			indent := memberIndentation(cd.MainError.Document, ctx.class)
+
+			// add the new constructor after the existing constructors
+			var after lib.SyntaxNode
+			for i := 0; i < int(body.NamedChildCount()); i++ {
+				if member := body.NamedChild(i); member.Type() == "constructor_declaration" || after.IsNull() {
+					after = member
+				}
+			}
+
+			parameters := make([]string, len(ctx.foundTypes))
+			for i, typ := range ctx.foundTypes {
+				parameters[i] = fmt.Sprintf("%s arg%d", typ, i+1)
+			}
+
+			constructor := fmt.Sprintf("%s%s(%s) {\n%s}", indent, className, strings.Join(parameters, ", "), indent)
+
+			var fix lib.FixSuggestion
+			if after.IsNull() {
+				insertPos := body.StartPosition()
+				insertPos.Column++
+				insertPos.Index++
+				fix = lib.FixSuggestion{NewText: "\n" + constructor, StartPosition: insertPos, EndPosition: insertPos}
+			} else {
+				fix = insertMemberAfter(cd.MainError.Document, after, constructor)
+			}
+
+			fix.Description = "Rename the parameters and use them to initialize the fields of the object."
+			s.AddStep("Add another constructor to `%s` which accepts %s.", className, describeTypeList(ctx.foundTypes)).AddFix(fix)
+		})
+	},
+}
+
+// splitTypeList splits the list of types reported by javac (eg. `String,int`)
+func splitTypeList(types string) []string {
+	types = strings.TrimSpace(types)
+	if types == "no arguments" {
+		return []string{}
+	}
+	return strings.Split(types, ",")
+}
+
+func describeTypeList(types []string) string {
+	if len(types) == 0 {
+		return "no arguments"
+	}
+
+	return fmt.Sprintf("`(%s)`", strings.Join(types, ", "))
+}
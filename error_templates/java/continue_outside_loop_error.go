@@ -0,0 +1,46 @@
+package java
+
+import (
+	lib "github.com/nedpals/errgoengine"
+)
+
+var ContinueOutsideLoopError = lib.ErrorTemplate{
+	Name:              "ContinueOutsideLoopError",
+	Pattern:           comptimeErrorPattern(`continue outside of loop`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		m.Context = analyzeJumpOutsideLoop(m, "continue_statement")
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(jumpOutsideLoopErrorCtx)
+		gen.Add("This error occurs when `continue` is used outside of a loop, where there is no next iteration to skip to.")
+		explainJumpOutsideLoop(gen, ctx, "continue")
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(jumpOutsideLoopErrorCtx)
+		if ctx.statement.IsNull() || ctx.enclosing.IsNull() {
+			return
+		}
+
+		if ctx.enclosing.Type() == "lambda_expression" {
+			// returning from the lambda skips to the next element just like `continue`
+			gen.Add("Use return instead", func(s *lib.BugFixSuggestion) {
+				keyword := ctx.statement.Child(0)
+				s.AddStep("Use `return` to stop running %s for the current element and move on to the next one.", describeEnclosingBody(ctx.enclosing)).
+					AddFix(lib.FixSuggestion{
+						NewText:       "return",
+						StartPosition: keyword.StartPosition(),
+						EndPosition:   keyword.EndPosition(),
+					})
+			})
+
+			addForEachLoopFix(cd, gen, ctx.enclosing, "continue")
+			return
+		}
+
+		gen.Add("Remove the continue statement", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Remove the `continue` since there is no loop to continue.").
+				AddFix(removeLineFix(cd.MainError.Document, ctx.statement))
+		})
+	},
+}
@@ -0,0 +1,146 @@
+package java
+
+import (
+	"fmt"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type duplicateCaseLabelErrorCtx struct {
+	label lib.SyntaxNode
+	// the value of the label which is already used by the first label
+	value      lib.SyntaxNode
+	firstLabel lib.SyntaxNode
+}
+
+var DuplicateCaseLabelError = lib.ErrorTemplate{
+	Name:              "DuplicateCaseLabelError",
+	Pattern:           comptimeErrorPattern(`duplicate case label`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		dCtx := duplicateCaseLabelErrorCtx{}
+		dCtx.label = nodeOnErrorLine(m, "label", `(switch_label) @label`)
+		if dCtx.label.IsNull() {
+			m.Context = dCtx
+			return
+		}
+
+		m.Nearest = dCtx.label
+
+		dCtx.firstLabel, dCtx.value = findLabelWithValue(dCtx.label.Parent().Parent(), dCtx.label)
+
+		if !dCtx.value.IsNull() {
+			m.Nearest = dCtx.value
+		}
+
+		m.Context = dCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(duplicateCaseLabelErrorCtx)
+		gen.Add("This error occurs when the same value is used by more than one `case` of a `switch`.")
+		if ctx.value.IsNull() {
+			return
+		}
+
+		gen.Add(
+			" `%s` already has a case for `%s` on line %d, so the second one can never be reached.",
+			switchHeader(enclosingSwitch(ctx.label)), ctx.value.Text(), ctx.firstLabel.StartPosition().Line+1,
+		)
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(duplicateCaseLabelErrorCtx)
+		if ctx.value.IsNull() {
+			return
+		}
+
+		doc := cd.MainError.Document
+		values := labelValues(ctx.label)
+		gen.Add("Remove the duplicate case", func(s *lib.BugFixSuggestion) {
+			if len(values) > 1 {
+				// remove only the duplicate value including the comma next to it
+				startPos, endPos := ctx.value.PrevSibling().StartPosition(), ctx.value.EndPosition()
+				if ctx.value.StartByte() == values[0].StartByte() {
+					startPos, endPos = ctx.value.StartPosition(), values[1].StartPosition()
+				}
+
+				s.AddStep("Remove `%s` from the values of the second case since it is already handled by the first one.", ctx.value.Text()).
+					AddFix(lib.FixSuggestion{
+						NewText:       "",
+						StartPosition: startPos,
+						EndPosition:   endPos,
+					})
+				return
+			}
+
+			// remove only the label if other labels share the same statements
+			member := ctx.label.Parent()
+			toRemove := member
+			for i := 0; i < int(member.NamedChildCount()); i++ {
+				if label := member.NamedChild(i); label.Type() == "switch_label" && label.StartByte() != ctx.label.StartByte() {
+					toRemove = ctx.label
+					break
+				}
+			}
+
+			// remove the whole lines if nothing else is on them
+			startPos, endPos := toRemove.StartPosition(), toRemove.EndPosition()
+			if len(strings.TrimSpace(doc.LineAt(startPos.Line)[:startPos.Column])) == 0 && len(strings.TrimSpace(doc.LineAt(endPos.Line)[endPos.Column:])) == 0 {
+				startPos, endPos = lib.Position{Line: startPos.Line}, lib.Position{Line: endPos.Line + 1}
+			}
+
+			s.AddStep("Remove the second `case %s` since it is already handled by the first one.", ctx.value.Text()).
+				AddFix(lib.FixSuggestion{
+					NewText:       "",
+					StartPosition: startPos,
+					EndPosition:   endPos,
+				})
+		})
+	},
+}
+
+// enclosingSwitch returns the switch of the label
+func enclosingSwitch(label lib.SyntaxNode) lib.SyntaxNode {
+	// label -> switch_block_statement_group or switch_rule -> switch_block -> switch_expression
+	return label.Parent().Parent().Parent()
+}
+
+// switchHeader returns the header of the switch (eg. `switch (day)`)
+func switchHeader(switchNode lib.SyntaxNode) string {
+	return fmt.Sprintf("switch %s", switchNode.ChildByFieldName("condition").Text())
+}
+
+// labelValues returns the values of the case label (eg. `1` and `2` in `case 1, 2`)
+func labelValues(label lib.SyntaxNode) []lib.SyntaxNode {
+	values := make([]lib.SyntaxNode, 0, label.NamedChildCount())
+	for i := 0; i < int(label.NamedChildCount()); i++ {
+		values = append(values, label.NamedChild(i))
+	}
+	return values
+}
+
+// findLabelWithValue returns the first label before the given label which shares one of its values
+func findLabelWithValue(switchBlock lib.SyntaxNode, label lib.SyntaxNode) (lib.SyntaxNode, lib.SyntaxNode) {
+	for i := 0; i < int(switchBlock.NamedChildCount()); i++ {
+		member := switchBlock.NamedChild(i)
+		if member.StartByte() >= label.StartByte() {
+			break
+		}
+
+		for j := 0; j < int(member.NamedChildCount()); j++ {
+			other := member.NamedChild(j)
+			if other.Type() != "switch_label" {
+				continue
+			}
+
+			for _, value := range labelValues(label) {
+				for _, otherValue := range labelValues(other) {
+					if value.Text() == otherValue.Text() {
+						return other, value
+					}
+				}
+			}
+		}
+	}
+	return lib.SyntaxNode{}, lib.SyntaxNode{}
+}
@@ -0,0 +1,142 @@
+package java
+
+import (
+	"regexp"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+var elseKeywordPattern = regexp.MustCompile(`\belse\b`)
+
+type elseWithoutIfErrorCtx struct {
+	elseNode    lib.SyntaxNode
+	ifStatement lib.SyntaxNode
+	// the semicolon which ends the if statement (eg. `if (x);`)
+	semicolon lib.SyntaxNode
+	// statements between the if statement and the else
+	strayStatements []lib.SyntaxNode
+}
+
+var ElseWithoutIfError = lib.ErrorTemplate{
+	Name:              "ElseWithoutIfError",
+	Pattern:           comptimeErrorPattern(`'else' without 'if'`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		eCtx := elseWithoutIfErrorCtx{}
+		errorLine := m.ErrorNode.StartPos.Line - 1
+		loc := elseKeywordPattern.FindStringIndex(m.Document.LineAt(errorLine))
+		if loc == nil {
+			m.Context = eCtx
+			return
+		}
+
+		// the parser does not recognize the else so it becomes part of other statements
+		eCtx.elseNode = m.Document.RootNode().NamedDescendantForPointRange(lib.Location{
+			StartPos: lib.Position{Line: errorLine, Column: loc[0]},
+			EndPos:   lib.Position{Line: errorLine, Column: loc[1]},
+		})
+		m.Nearest = eCtx.elseNode
+
+		statement := eCtx.elseNode
+		for !statement.IsNull() && statement.Parent().Type() != "block" {
+			statement = statement.Parent()
+		}
+
+		// collect the statements until the if statement before the else
+		for prev := statement.PrevNamedSibling(); !prev.IsNull(); prev = prev.PrevNamedSibling() {
+			if prev.Type() == "if_statement" {
+				eCtx.ifStatement = prev
+				eCtx.semicolon = emptyBody(prev)
+				break
+			}
+			eCtx.strayStatements = append([]lib.SyntaxNode{prev}, eCtx.strayStatements...)
+		}
+
+		m.Context = eCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(elseWithoutIfErrorCtx)
+		gen.Add("This error occurs when there is an `else` that does not belong to any `if` statement.")
+		if ctx.ifStatement.IsNull() {
+			return
+		}
+
+		header := statementHeader(ctx.ifStatement)
+		if !ctx.semicolon.IsNull() {
+			gen.Add(" The semicolon after `%s` ends the `if` statement, so the code after it is not part of the `if` and the `else` is left without one.", header)
+		} else if ctx.ifStatement.ChildByFieldName("consequence").Type() != "block" {
+			gen.Add(" Without braces, only the first statement after `%s` belongs to the `if`, so the statements after it separate the `else` from the `if`.", header)
+		} else if len(ctx.strayStatements) != 0 {
+			gen.Add(" The block of `%s` is closed before the statements that come before the `else`, so the `else` is separated from the `if`.", header)
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(elseWithoutIfErrorCtx)
+		if ctx.ifStatement.IsNull() || len(ctx.strayStatements) == 0 {
+			return
+		}
+
+		doc := cd.MainError.Document
+		header := statementHeader(ctx.ifStatement)
+		consequence := ctx.ifStatement.ChildByFieldName("consequence")
+		elsePos := ctx.elseNode.StartPosition()
+
+		if !ctx.semicolon.IsNull() {
+			if len(ctx.strayStatements) != 1 {
+				return
+			}
+
+			gen.Add("Remove the semicolon after the condition", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Remove the `;` after `%s` so that the code after it becomes part of the `if` statement.", header).
+					AddFix(lib.FixSuggestion{
+						NewText:       "",
+						StartPosition: ctx.semicolon.StartPosition(),
+						EndPosition:   ctx.semicolon.EndPosition(),
+					})
+			})
+		} else if consequence.Type() != "block" {
+			gen.Add("Wrap the statements in braces", func(s *lib.BugFixSuggestion) {
+				condition := ctx.ifStatement.ChildByFieldName("condition")
+				s.AddStep("Add an opening brace after `%s`.", header).
+					AddFix(lib.FixSuggestion{
+						NewText:       " {",
+						StartPosition: condition.EndPosition(),
+						EndPosition:   condition.EndPosition(),
+					})
+
+				s.AddStep("Add a closing brace before the `else` so that all of the statements before it belong to the `if`.").
+					AddFix(lib.FixSuggestion{
+						NewText:       "} ",
+						StartPosition: elsePos,
+						EndPosition:   elsePos,
+					})
+			})
+		} else {
+			gen.Add("Move the closing brace", func(s *lib.BugFixSuggestion) {
+				closingBrace := consequence.Child(int(consequence.ChildCount()) - 1)
+				braceStart, braceEnd := closingBrace.StartPosition(), closingBrace.EndPosition()
+
+				// remove the whole line if it only has the closing brace
+				if line := doc.LineAt(braceStart.Line); len(strings.TrimSpace(line[:braceStart.Column])) == 0 && braceEnd.Column == len(line) {
+					braceStart = lib.Position{Line: braceStart.Line}
+				}
+
+				s.AddStep("Close the block of `%s` right before the `else` so that the statements before it are part of the `if`.", header).
+					AddFix(lib.FixSuggestion{
+						NewText:       "} ",
+						StartPosition: elsePos,
+						EndPosition:   elsePos,
+					})
+
+				// remove the brace last since removing its line shifts the lines after it
+				s.AddStep("Remove the old closing brace of `%s`.", header).
+					AddFix(lib.FixSuggestion{
+						NewText:       "",
+						StartPosition: braceStart,
+						EndPosition:   braceEnd,
+					})
+			})
+		}
+	},
+}
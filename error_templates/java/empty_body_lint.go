@@ -0,0 +1,59 @@
+package java
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+// LintEmptyBodies adds a suggestion for every if statement or loop in the
+// document of the generator whose body is only a semicolon (eg. `if (x);`).
+// The code still compiles but the statements after the semicolon always run,
+// which often causes the error at runtime. Semicolons which are already
+// removed by the other suggestions are skipped.
+func LintEmptyBodies(gen *lib.BugFixGenerator) {
+	if gen.Document == nil {
+		return
+	}
+
+	rootNode := gen.Document.RootNode()
+	for q := rootNode.Query(`[(if_statement) (for_statement) (enhanced_for_statement) (while_statement)] @statement`); q.Next(); {
+		statement := q.CurrentNode()
+		semicolon := emptyBody(statement)
+		if semicolon.IsNull() || hasFixAt(gen, semicolon.StartPosition()) {
+			continue
+		}
+
+		header := statementHeader(statement)
+		gen.Add(fmt.Sprintf("Remove the semicolon after `%s`", header), func(s *lib.BugFixSuggestion) {
+			s.AddStep("The `;` after `%s` is an empty statement, so the code after it is not part of the `%s` and always runs once. Remove it so that the code after it becomes the body.", header, statement.Child(0).Type()).
+				AddFix(lib.FixSuggestion{
+					NewText:       "",
+					StartPosition: semicolon.StartPosition(),
+					EndPosition:   semicolon.EndPosition(),
+				})
+		})
+	}
+}
+
+// withEmptyBodyLint runs LintEmptyBodies after the suggestions of the template
+func withEmptyBodyLint(fn func(cd *lib.ContextData, gen *lib.BugFixGenerator)) func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+	return func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		fn(cd, gen)
+		LintEmptyBodies(gen)
+	}
+}
+
+// hasFixAt checks if any of the suggestions has a fix which starts at the position
+func hasFixAt(gen *lib.BugFixGenerator, pos lib.Position) bool {
+	for _, suggestion := range gen.Suggestions {
+		for _, step := range suggestion.Steps {
+			for _, fix := range step.Fixes {
+				if fix.StartPosition.Line == pos.Line && fix.StartPosition.Column == pos.Column {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
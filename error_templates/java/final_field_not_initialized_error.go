@@ -0,0 +1,97 @@
+package java
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type finalFieldNotInitializedErrorCtx struct {
+	fieldSym    *lib.VariableSymbol
+	declarator  lib.SyntaxNode
+	declaration lib.SyntaxNode
+	class       lib.SyntaxNode
+}
+
+var FinalFieldNotInitializedError = lib.ErrorTemplate{
+	Name:              "FinalFieldNotInitializedError",
+	Pattern:           comptimeErrorPattern(`variable (?P<variable>\S+) not initialized in the default constructor`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		fCtx := finalFieldNotInitializedErrorCtx{}
+		name := nodeOnErrorLine(m, "name", `(field_declaration declarator: (variable_declarator name: (identifier) @name (#eq? @name "%s")))`, cd.Variables["variable"])
+		if name.IsNull() {
+			m.Context = fCtx
+			return
+		}
+
+		m.Nearest = name
+		fCtx.declarator = name.Parent()
+		fCtx.declaration = fCtx.declarator.Parent()
+		fCtx.class = fCtx.declaration.Parent().Parent()
+
+		tree := cd.InitOrGetSymbolTree(cd.MainDocumentPath()).GetNearestScopedTree(name.StartPosition().Index)
+		if sym, ok := tree.Find(name.Text()).(*lib.VariableSymbol); ok {
+			fCtx.fieldSym = sym
+		}
+
+		m.Context = fCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		gen.Add("This error occurs when a `final` field is not given a value. ")
+		gen.Add("The class does not have a constructor, so the default constructor created by Java leaves `%s` without a value.", cd.Variables["variable"])
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(finalFieldNotInitializedErrorCtx)
+		if ctx.declarator.IsNull() {
+			return
+		}
+
+		variable := cd.Variables["variable"]
+		fieldType := ctx.declaration.ChildByFieldName("type").Text()
+		defaultValue := "null"
+		if ctx.fieldSym != nil {
+			defaultValue = getDefaultValueForType(ctx.fieldSym.ReturnType())
+		}
+
+		gen.Add("Initialize the field", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Give `%s` a value where it is declared.", variable).
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf(" = %s", defaultValue),
+					StartPosition: ctx.declarator.EndPosition(),
+					EndPosition:   ctx.declarator.EndPosition(),
+				})
+		})
+
+		if ctx.class.Type() != "class_declaration" {
+			return
+		}
+
+		gen.Add("Add a constructor", func(s *lib.BugFixSuggestion) {
+			className := ctx.class.ChildByFieldName("name").Text()
+			startPos := ctx.declaration.StartPosition()
+			spaces := getSpaceFromBeginning(cd.MainError.Document, startPos.Line, startPos.Column)
+
+			constructor := fmt.Sprintf(
+				"%s(%s %s) {\n%s%sthis.%s = %s;\n%s}",
+				className, fieldType, variable,
+				spaces, getIndent(spaces, 1), variable, variable,
+				spaces,
+			)
+
+			// add the constructor after the last field of the class
+			lastField := ctx.declaration
+			body := ctx.declaration.Parent()
+			for i := 0; i < int(body.NamedChildCount()); i++ {
+				if member := body.NamedChild(i); member.Type() == "field_declaration" {
+					lastField = member
+				}
+			}
+
+			fix := insertMemberAfter(cd.MainError.Document, lastField, spaces+constructor)
+			fix.Description = fmt.Sprintf("Objects of `%s` should now be created with the value of `%s`.", className, variable)
+
+			s.AddStep("Add a constructor to `%s` which sets the value of `%s`.", className, variable).AddFix(fix)
+		})
+	},
+}
@@ -0,0 +1,87 @@
+package java
+
+import (
+	lib "github.com/nedpals/errgoengine"
+)
+
+type finalVariableAssignmentErrorCtx struct {
+	assignment  lib.SyntaxNode
+	declaration lib.SyntaxNode
+}
+
+var FinalVariableAssignmentError = lib.ErrorTemplate{
+	Name:              "FinalVariableAssignmentError",
+	Pattern:           comptimeErrorPattern(`cannot assign a value to final variable (?P<variable>\S+)`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		fCtx := finalVariableAssignmentErrorCtx{}
+		fCtx.assignment = nodeOnErrorLine(m, "assignment", `[
+			(assignment_expression left: [(identifier) (field_access)] @left) @assignment
+			(update_expression) @assignment
+		]`)
+
+		if !fCtx.assignment.IsNull() {
+			m.Nearest = fCtx.assignment
+			fCtx.declaration = findVariableDeclaration(cd, m.Document, fCtx.assignment, cd.Variables["variable"])
+		}
+
+		m.Context = fCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		gen.Add("This error occurs when trying to change the value of a variable declared as `final`. ")
+		gen.Add("Once `%s` is given a value, it cannot be assigned again.", cd.Variables["variable"])
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(finalVariableAssignmentErrorCtx)
+		if ctx.declaration.IsNull() || !hasModifier(ctx.declaration, "final") {
+			return
+		}
+
+		gen.Add("Remove the final modifier", func(s *lib.BugFixSuggestion) {
+			s.AddStep("If `%s` is meant to change, remove `final` from its declaration.", cd.Variables["variable"]).
+				AddFix(removeFinalModifierFix(ctx.declaration))
+		})
+	},
+}
+
+// findVariableDeclaration returns the declaration of the local variable or field
+// with the given name that is visible from the node
+func findVariableDeclaration(cd *lib.ContextData, doc *lib.Document, node lib.SyntaxNode, name string) lib.SyntaxNode {
+	tree := cd.InitOrGetSymbolTree(cd.MainDocumentPath()).GetNearestScopedTree(node.StartPosition().Index)
+	found := tree.Find(name)
+
+	// assignments of the variable in the same scope replace its symbol
+	for assignment, ok := found.(*lib.AssignmentSymbol); ok; assignment, ok = found.(*lib.AssignmentSymbol) {
+		found = assignment.Variable
+	}
+
+	sym, ok := found.(*lib.VariableSymbol)
+	if !ok {
+		return lib.SyntaxNode{}
+	}
+
+	// the location of the symbol points to either the declarator or the name of the variable
+	declarator := doc.RootNode().NamedDescendantForPointRange(sym.Location())
+	if declarator.Type() == "identifier" {
+		declarator = declarator.Parent()
+	}
+
+	if declarator.Type() != "variable_declarator" {
+		return lib.SyntaxNode{}
+	}
+	return declarator.Parent()
+}
+
+// removeFinalModifierFix removes the `final` modifier including the space after it
+func removeFinalModifierFix(declaration lib.SyntaxNode) lib.FixSuggestion {
+	finalModifier := childOfType(childOfType(declaration, "modifiers"), "final")
+	endPos := finalModifier.EndPosition()
+	endPos.Column++
+	endPos.Index++
+
+	return lib.FixSuggestion{
+		NewText:       "",
+		StartPosition: finalModifier.StartPosition(),
+		EndPosition:   endPos,
+	}
+}
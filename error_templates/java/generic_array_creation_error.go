@@ -0,0 +1,123 @@
+package java
+
+import (
+	"fmt"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type genericArrayCreationErrorCtx struct {
+	creation    lib.SyntaxNode
+	elementType lib.SyntaxNode
+	// the element type is a type parameter of the class or method (eg. `T`)
+	isTypeParameter bool
+}
+
+var GenericArrayCreationError = lib.ErrorTemplate{
+	Name:              "GenericArrayCreationError",
+	Pattern:           comptimeErrorPattern(`generic array creation`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		gCtx := genericArrayCreationErrorCtx{}
+		gCtx.creation = nodeOnErrorLine(m, "creation", `(array_creation_expression) @creation`)
+		if gCtx.creation.IsNull() {
+			m.Context = gCtx
+			return
+		}
+
+		m.Nearest = gCtx.creation
+		gCtx.elementType = gCtx.creation.ChildByFieldName("type")
+		if gCtx.elementType.Type() == "type_identifier" {
+			gCtx.isTypeParameter = isTypeParameter(gCtx.creation, gCtx.elementType.Text())
+		}
+
+		m.Context = gCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(genericArrayCreationErrorCtx)
+		gen.Add("This error occurs when creating an array whose elements have a generic type. Java removes the type arguments once the program is compiled (also known as type erasure), so it cannot check the type of the elements stored in the array while the program runs.")
+		if ctx.creation.IsNull() {
+			return
+		}
+
+		if ctx.isTypeParameter {
+			gen.Add(" The actual type of `%s` is not known while the program runs, so an array of `%s` cannot be created.", ctx.elementType.Text(), ctx.elementType.Text())
+		} else if ctx.elementType.Type() == "generic_type" {
+			gen.Add(" Only `%s` is known while the program runs, so an array of `%s` cannot be created.", ctx.elementType.NamedChild(0).Text(), ctx.elementType.Text())
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(genericArrayCreationErrorCtx)
+		if ctx.creation.IsNull() {
+			return
+		}
+
+		if ctx.isTypeParameter {
+			gen.Add("Create an Object array instead", func(s *lib.BugFixSuggestion) {
+				typeParam := ctx.elementType.Text()
+				dimensions := arrayCreationDimensions(ctx.creation)
+				s.AddStep("Create an `Object` array and cast it to `%s[]`.", typeParam).
+					AddFix(lib.FixSuggestion{
+						NewText:       fmt.Sprintf("(%s%s) new Object%s", typeParam, strings.Repeat("[]", len(dimensions)), strings.Join(dimensions, "")),
+						StartPosition: ctx.creation.StartPosition(),
+						EndPosition:   ctx.creation.EndPosition(),
+						Description:   "Java shows an unchecked warning for this cast. It is safe as long as the array is only used inside the class.",
+					})
+			})
+			return
+		}
+
+		if ctx.elementType.Type() == "generic_type" {
+			typeArgs := ctx.elementType.NamedChild(1)
+			gen.Add("Remove the type arguments", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Remove `%s` from the array creation so that an array of `%s` is created instead.", typeArgs.Text(), ctx.elementType.NamedChild(0).Text()).
+					AddFix(lib.FixSuggestion{
+						NewText:       "",
+						StartPosition: typeArgs.StartPosition(),
+						EndPosition:   typeArgs.EndPosition(),
+						Description:   "Java shows an unchecked warning since the elements of the array are not checked. A list of lists can be used instead to avoid arrays of generic types altogether.",
+					})
+			})
+		}
+	},
+}
+
+// isTypeParameter checks if the name is a type parameter of the classes or
+// methods containing the node
+func isTypeParameter(node lib.SyntaxNode, name string) bool {
+	for parent := node.Parent(); !parent.IsNull(); parent = parent.Parent() {
+		switch parent.Type() {
+		case "class_declaration", "interface_declaration", "record_declaration", "method_declaration", "constructor_declaration":
+			typeParams := parent.ChildByFieldName("type_parameters")
+			if typeParams.IsNull() {
+				continue
+			}
+
+			for i := 0; i < int(typeParams.NamedChildCount()); i++ {
+				if typeParams.NamedChild(i).NamedChild(0).Text() == name {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// arrayCreationDimensions returns the dimensions of the array creation (eg. `[size]` and `[]` in `new int[size][]`)
+func arrayCreationDimensions(creation lib.SyntaxNode) []string {
+	dimensions := []string{}
+	for i := 0; i < int(creation.NamedChildCount()); i++ {
+		child := creation.NamedChild(i)
+		switch child.Type() {
+		case "dimensions_expr":
+			dimensions = append(dimensions, child.Text())
+		case "dimensions":
+			// unsized dimensions are written together (eg. `[][]`)
+			for j := 0; j < strings.Count(child.Text(), "["); j++ {
+				dimensions = append(dimensions, "[]")
+			}
+		}
+	}
+	return dimensions
+}
@@ -0,0 +1,117 @@
+package java
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type incomparableTypesErrorCtx struct {
+	comparison lib.SyntaxNode
+	hierarchy  string
+}
+
+var IncomparableTypesError = lib.ErrorTemplate{
+	Name:              "IncomparableTypesError",
+	Pattern:           comptimeErrorPattern(`incomparable types: (?P<leftType>\S+) and (?P<rightType>\S+)`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		iCtx := incomparableTypesErrorCtx{}
+		errorLine := m.ErrorNode.StartPos.Line - 1
+
+		for nearest := m.Nearest; !nearest.IsNull() && iCtx.comparison.IsNull(); nearest = nearest.Parent() {
+			for q := nearest.Query(`(binary_expression operator: ["==" "!="]) @comparison`); q.Next(); {
+				node := q.CurrentNode()
+				if node.ChildByFieldName("operator").StartPosition().Line == errorLine {
+					iCtx.comparison = node
+					break
+				}
+			}
+		}
+
+		if !iCtx.comparison.IsNull() {
+			m.Nearest = iCtx.comparison
+		}
+
+		tree := cd.InitOrGetSymbolTree(cd.MainDocumentPath()).GetNearestScopedTree(m.Nearest.StartPosition().Index)
+		iCtx.hierarchy = describeClassRelationship(
+			classHierarchy(m.Document, tree, cd.Variables["leftType"]),
+			classHierarchy(m.Document, tree, cd.Variables["rightType"]),
+		)
+
+		m.Context = iCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(incomparableTypesErrorCtx)
+		gen.Add("This error occurs when you compare two objects using `==` or `!=` whose types can never refer to the same object. ")
+		gen.Add(ctx.hierarchy)
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(incomparableTypesErrorCtx)
+		if ctx.comparison.IsNull() {
+			return
+		}
+
+		leftType := cd.Variables["leftType"]
+		rightType := cd.Variables["rightType"]
+		left := ctx.comparison.ChildByFieldName("left")
+		right := ctx.comparison.ChildByFieldName("right")
+		negation := ""
+		if ctx.comparison.ChildByFieldName("operator").Type() == "!=" {
+			negation = "!"
+		}
+
+		if leftType == "String" || rightType == "String" {
+			// convert the non-string side so that both of them can be compared as strings
+			leftText, rightText := left.Text(), right.Text()
+			otherType := rightType
+			if leftType == "String" {
+				rightText, _ = convertValueToClass(rightText, rightType, "String")
+			} else {
+				otherType = leftType
+				leftText, _ = convertValueToClass(leftText, leftType, "String")
+			}
+
+			gen.Add(fmt.Sprintf("Convert the %s into a String", otherType), func(s *lib.BugFixSuggestion) {
+				s.AddStep("Convert the `%s` into a `String` and compare their contents using the `equals` method.", otherType).
+					AddFix(lib.FixSuggestion{
+						NewText:       fmt.Sprintf("%s%s.equals(%s)", negation, leftText, rightText),
+						StartPosition: ctx.comparison.StartPosition(),
+						EndPosition:   ctx.comparison.EndPosition(),
+						Description:   "Strings should always be compared with `equals` since `==` only checks if both refer to the same object.",
+					})
+			})
+
+			// compare both as numbers if the other side is a number
+			if parseMethod, ok := parseMethodsByClass[otherType]; ok {
+				gen.Add("Parse the String into a number", func(s *lib.BugFixSuggestion) {
+					fix := lib.FixSuggestion{
+						NewText:       fmt.Sprintf("%s.%s(%s)", otherType, parseMethod, left.Text()),
+						StartPosition: left.StartPosition(),
+						EndPosition:   left.EndPosition(),
+					}
+
+					if rightType == "String" {
+						fix.NewText = fmt.Sprintf("%s.%s(%s)", otherType, parseMethod, right.Text())
+						fix.StartPosition = right.StartPosition()
+						fix.EndPosition = right.EndPosition()
+					}
+
+					s.AddStep("If the `String` contains a number, parse it using `%s.%s` so that both values are compared as numbers.", otherType, parseMethod).
+						AddFix(fix)
+				})
+			}
+			return
+		}
+
+		gen.Add("Use equals to compare the objects", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Compare the objects using the `equals` method instead of `%s`.", ctx.comparison.ChildByFieldName("operator").Type()).
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("%s%s.equals(%s)", negation, left.Text(), right.Text()),
+					StartPosition: ctx.comparison.StartPosition(),
+					EndPosition:   ctx.comparison.EndPosition(),
+					Description:   fmt.Sprintf("Make sure that `%s` overrides `equals` to define when it equals `%s` objects.", leftType, rightType),
+				})
+		})
+	},
+}
@@ -1,13 +1,41 @@
 package java
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 
 	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/languages/java"
 )
 
+// generic classes which are often used as raw types along with the number
+// of their type parameters
+var genericClasses = map[string]int{
+	"List":       1,
+	"ArrayList":  1,
+	"LinkedList": 1,
+	"Set":        1,
+	"HashSet":    1,
+	"TreeSet":    1,
+	"Queue":      1,
+	"Deque":      1,
+	"ArrayDeque": 1,
+	"Iterator":   1,
+	"Optional":   1,
+	"Map":        2,
+	"HashMap":    2,
+	"TreeMap":    2,
+}
+
 type incompatibleTypesErrorCtx struct {
 	Parent lib.SyntaxNode
+	Cast   lib.SyntaxNode
+	// label of a switch whose value does not match the type checked by the switch
+	Label lib.SyntaxNode
+	// method call on a variable declared with a raw type (eg. `ArrayList` instead of `ArrayList<String>`)
+	RawCall        lib.SyntaxNode
+	RawDeclaration lib.SyntaxNode
 }
 
 var IncompatibleTypesError = lib.ErrorTemplate{
@@ -17,6 +45,62 @@ var IncompatibleTypesError = lib.ErrorTemplate{
 	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
 		iCtx := incompatibleTypesErrorCtx{}
 
+		// casts between unrelated classes (eg. `(Integer) text`) produce the same error
+		errorLine := m.ErrorNode.StartPos.Line - 1
+		for nearest := m.Nearest; !nearest.IsNull() && iCtx.Cast.IsNull(); nearest = nearest.Parent() {
+			for q := nearest.Query(`(cast_expression type: (_) @type) @cast`); q.Next(); {
+				if q.CurrentTagName() != "cast" {
+					continue
+				}
+
+				node := q.CurrentNode()
+				if node.StartPosition().Line == errorLine && node.ChildByFieldName("type").Text() == cd.Variables["rightType"] {
+					iCtx.Cast = node
+					break
+				}
+			}
+		}
+
+		if !iCtx.Cast.IsNull() {
+			m.Nearest = iCtx.Cast
+			m.Context = iCtx
+			return
+		}
+
+		if label := nodeOnErrorLine(m, "label", `(switch_label) @label`); !label.IsNull() {
+			iCtx.Label = label
+			m.Nearest = label
+			for _, value := range labelValues(label) {
+				if literalType(value) == cd.Variables["leftType"] {
+					m.Nearest = value
+					break
+				}
+			}
+
+			m.Context = iCtx
+			return
+		}
+
+		// raw types return objects from their methods
+		if cd.Variables["leftType"] == "Object" {
+			for q := m.Document.RootNode().Query(`(method_invocation object: (identifier) @object) @call`); q.Next(); {
+				call := q.CurrentNode()
+				if q.CurrentTagName() != "call" || call.StartPosition().Line != errorLine {
+					continue
+				}
+
+				object := call.ChildByFieldName("object")
+				declaration := findVariableDeclaration(cd, m.Document, object, object.Text())
+				if !declaration.IsNull() && isRawType(cd, declaration.ChildByFieldName("type")) {
+					iCtx.RawCall = call
+					iCtx.RawDeclaration = declaration
+					m.Nearest = call
+					m.Context = iCtx
+					return
+				}
+			}
+		}
+
 		if m.Nearest.Type() == "expression_statement" {
 			m.Nearest = m.Nearest.NamedChild(0)
 		}
@@ -29,13 +113,99 @@ var IncompatibleTypesError = lib.ErrorTemplate{
 		m.Context = iCtx
 	},
 	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(incompatibleTypesErrorCtx)
+		if !ctx.Cast.IsNull() {
+			tree := cd.InitOrGetSymbolTree(cd.MainDocumentPath()).GetNearestScopedTree(ctx.Cast.StartPosition().Index)
+			gen.Add("This error occurs when you attempt to cast a value into a type that it can never be converted to. ")
+			gen.Add(describeClassRelationship(
+				classHierarchy(cd.MainError.Document, tree, cd.Variables["leftType"]),
+				classHierarchy(cd.MainError.Document, tree, cd.Variables["rightType"]),
+			))
+			return
+		}
+
+		if !ctx.Label.IsNull() {
+			gen.Add("This error occurs when the value of a `case` has a different type from the value checked by the `switch`. ")
+			gen.Add(
+				"`%s` checks a value of type `%s`, but `case %s` has a value of type `%s`.",
+				switchHeader(enclosingSwitch(ctx.Label)), cd.Variables["rightType"], cd.MainError.Nearest.Text(), cd.Variables["leftType"],
+			)
+			return
+		}
+
 		gen.Add("This error occurs when you attempt to assign a value of one data type to a variable of a different, incompatible data type.")
+		if !ctx.RawCall.IsNull() {
+			object := ctx.RawCall.ChildByFieldName("object").Text()
+			gen.Add(
+				" `%s` is declared with the raw type `%s`, which does not say what type of values it holds, so `%s` gives back an `Object`.",
+				object, ctx.RawDeclaration.ChildByFieldName("type").Text(), ctx.RawCall.Text(),
+			)
+		}
 	},
 	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
 		leftType := cd.Variables["leftType"]
 		rightType := cd.Variables["rightType"]
 		ctx := cd.MainError.Context.(incompatibleTypesErrorCtx)
 
+		if !ctx.Cast.IsNull() {
+			valueNode := ctx.Cast.ChildByFieldName("value")
+			if conversion, ok := convertValueToClass(valueNode.Text(), leftType, rightType); ok {
+				gen.Add(fmt.Sprintf("Convert %s to %s", leftType, rightType), func(s *lib.BugFixSuggestion) {
+					s.AddStep("Instead of casting, convert the `%s` value into the `%s` type.", leftType, rightType).
+						AddFix(lib.FixSuggestion{
+							NewText:       conversion,
+							StartPosition: ctx.Cast.StartPosition(),
+							EndPosition:   ctx.Cast.EndPosition(),
+						})
+				})
+			}
+			return
+		}
+
+		if !ctx.Label.IsNull() {
+			value := cd.MainError.Nearest
+			if converted, ok := convertLiteral(value, rightType); ok {
+				gen.Add(fmt.Sprintf("Convert the case value to %s", rightType), func(s *lib.BugFixSuggestion) {
+					s.AddStep("Change `%s` to `%s` so that it matches the type checked by `%s`.", value.Text(), converted, switchHeader(enclosingSwitch(ctx.Label))).
+						AddFix(lib.FixSuggestion{
+							NewText:       converted,
+							StartPosition: value.StartPosition(),
+							EndPosition:   value.EndPosition(),
+						})
+				})
+			}
+			return
+		}
+
+		if !ctx.RawCall.IsNull() {
+			typeNode := ctx.RawDeclaration.ChildByFieldName("type")
+			if genericClasses[typeNode.Text()] != 1 {
+				return
+			}
+
+			gen.Add("Add type arguments to the declaration", func(s *lib.BugFixSuggestion) {
+				step := s.AddStep(
+					"Add `<%s>` to the type of `%s` so that Java knows that it holds `%s` values.",
+					rightType, ctx.RawCall.ChildByFieldName("object").Text(), rightType,
+				).AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("<%s>", rightType),
+					StartPosition: typeNode.EndPosition(),
+					EndPosition:   typeNode.EndPosition(),
+				})
+
+				// let Java infer the type arguments of the created object
+				value := ctx.RawDeclaration.ChildByFieldName("declarator").ChildByFieldName("value")
+				if value.Type() == "object_creation_expression" && value.ChildByFieldName("type").Type() == "type_identifier" {
+					step.AddFix(lib.FixSuggestion{
+						NewText:       "<>",
+						StartPosition: value.ChildByFieldName("type").EndPosition(),
+						EndPosition:   value.ChildByFieldName("type").EndPosition(),
+					})
+				}
+			})
+			return
+		}
+
 		gen.Add(fmt.Sprintf("Convert %s to %s", leftType, rightType), func(s *lib.BugFixSuggestion) {
 			s.AddStep("To resolve the incompatible types error, you need to explicitly convert the `%s` to a `%s`.", leftType, rightType).
 				AddFix(lib.FixSuggestion{
@@ -59,3 +229,63 @@ var IncompatibleTypesError = lib.ErrorTemplate{
 		})
 	},
 }
+
+// literalType returns the type of the literal value (eg. `String` for string literals)
+func literalType(node lib.SyntaxNode) string {
+	switch node.Type() {
+	case "string_literal":
+		return "String"
+	case "character_literal":
+		return "char"
+	case "decimal_integer_literal", "hex_integer_literal", "octal_integer_literal", "binary_integer_literal":
+		return "int"
+	case "decimal_floating_point_literal":
+		return "double"
+	case "true", "false":
+		return "boolean"
+	default:
+		return ""
+	}
+}
+
+// convertLiteral rewrites the literal value into a literal of the target type
+// if it represents the same value (eg. `"1"` into `1`)
+func convertLiteral(node lib.SyntaxNode, targetType string) (string, bool) {
+	text := node.Text()
+	switch literalType(node) {
+	case "String", "char":
+		content := text[1 : len(text)-1]
+		switch targetType {
+		case "int", "long", "short", "byte", "Integer":
+			if _, err := strconv.Atoi(content); err == nil {
+				return content, true
+			}
+		case "char", "Character":
+			if len(content) == 1 {
+				return fmt.Sprintf("'%s'", content), true
+			}
+		case "String":
+			return fmt.Sprintf("\"%s\"", content), true
+		}
+	case "int":
+		switch targetType {
+		case "String":
+			return fmt.Sprintf("\"%s\"", text), true
+		case "char", "Character":
+			if len(text) == 1 {
+				return fmt.Sprintf("'%s'", text), true
+			}
+		}
+	}
+	return "", false
+}
+
+// isRawType checks if the type is a generic class used without type arguments
+func isRawType(cd *lib.ContextData, typeNode lib.SyntaxNode) bool {
+	if _, ok := genericClasses[typeNode.Text()]; !ok {
+		return false
+	}
+
+	_, isGeneric := cd.Analyzer.AnalyzeNode(context.Background(), typeNode).(java.GenericSymbol)
+	return !isGeneric
+}
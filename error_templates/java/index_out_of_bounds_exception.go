@@ -0,0 +1,79 @@
+package java
+
+import (
+	"fmt"
+	"strconv"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type indexOutOfBoundsExceptionCtx struct {
+	call      lib.SyntaxNode
+	statement lib.SyntaxNode
+}
+
+var IndexOutOfBoundsException = lib.ErrorTemplate{
+	Name:    "IndexOutOfBoundsException",
+	Pattern: runtimeErrorPattern("java.lang.IndexOutOfBoundsException", `Index (?P<index>-?\d+) out of bounds for length (?P<length>\d+)`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := indexOutOfBoundsExceptionCtx{}
+		ctx.call = nodeOnErrorLine(m, "call", `((method_invocation
+			object: (_)
+			name: (identifier) @name
+			arguments: (argument_list . (_))) @call
+			(#match? @name "^(get|set|remove)$"))`)
+
+		if !ctx.call.IsNull() {
+			m.Nearest = ctx.call.ChildByFieldName("arguments").FirstNamedChild()
+			ctx.statement = getStatementNode(ctx.call)
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		length, _ := strconv.Atoi(cd.Variables["length"])
+		if length == 0 {
+			gen.Add("This error occurs because the code is trying to access index %s of an empty list.", cd.Variables["index"])
+			return
+		}
+
+		gen.Add(
+			"This error occurs because the code is trying to access index %s of a list which only has %d items. List indexes start at 0, so the last valid index is %d.",
+			cd.Variables["index"], length, length-1)
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(indexOutOfBoundsExceptionCtx)
+		if ctx.call.IsNull() {
+			return
+		}
+
+		doc := cd.MainError.Document
+		length, _ := strconv.Atoi(cd.Variables["length"])
+		list := ctx.call.ChildByFieldName("object").Text()
+		indexNode := cd.MainError.Nearest
+
+		if length > 0 {
+			gen.Add("Use an index within the size of the list", func(s *lib.BugFixSuggestion) {
+				newIndex := fmt.Sprintf("%s.size() - 1", list)
+				if indexNode.Type() == "decimal_integer_literal" {
+					newIndex = strconv.Itoa(length - 1)
+				}
+
+				s.AddStep("Change the index to one that exists in `%s`, such as the index of its last item.", list).
+					AddFix(lib.FixSuggestion{
+						NewText:       newIndex,
+						StartPosition: indexNode.StartPosition(),
+						EndPosition:   indexNode.EndPosition(),
+					})
+			})
+		}
+
+		if !ctx.statement.IsNull() {
+			gen.Add("Check the size of the list first", func(s *lib.BugFixSuggestion) {
+				step := s.AddStep("Make sure that the index is less than the size of `%s` before calling `%s`.", list, ctx.call.ChildByFieldName("name").Text())
+				wrapStatements(step, doc, ctx.statement, lastStatementUsingDeclaration(ctx.statement), fmt.Sprintf("if (%s < %s.size()) {", indexNode.Text(), list), "}")
+				step.Fixes[len(step.Fixes)-1].Description = "This skips the statement when the list does not have enough items."
+			})
+		}
+	},
+}
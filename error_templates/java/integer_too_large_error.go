@@ -0,0 +1,148 @@
+package java
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/utils/numbers"
+)
+
+type integerTooLargeErrorCtx struct {
+	literal lib.SyntaxNode
+	// the digits of the literal without underscores and suffix
+	digits string
+	value  *big.Int
+	// the literal starts with 0 but contains digits which are not octal
+	invalidOctal bool
+	// the declaration of the variable which is given the literal, if any
+	declaration lib.SyntaxNode
+}
+
+var IntegerTooLargeError = lib.ErrorTemplate{
+	Name:              "IntegerTooLargeError",
+	Pattern:           comptimeErrorPattern(`integer number too large(?:: (?P<number>\S+))?`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		iCtx := integerTooLargeErrorCtx{}
+		number := cd.Variables["number"]
+
+		for q := m.Document.RootNode().Query(`[(decimal_integer_literal) (hex_integer_literal) (octal_integer_literal) (binary_integer_literal)] @literal`); q.Next(); {
+			literal := q.CurrentNode()
+			if literal.StartPosition().Line != m.ErrorNode.StartPos.Line-1 {
+				continue
+			}
+
+			value, isLong, ok := numbers.ParseInteger(literal.Text())
+			if len(number) != 0 && trimIntegerLiteral(literal.Text()) == number ||
+				len(number) == 0 && (!ok || (!isLong && !numbers.FitsInt32(value)) || !numbers.FitsInt64(value)) {
+				iCtx.literal = literal
+				iCtx.value = value
+				break
+			}
+		}
+
+		if iCtx.literal.IsNull() {
+			m.Context = iCtx
+			return
+		}
+
+		m.Nearest = iCtx.literal
+		iCtx.digits = trimIntegerLiteral(iCtx.literal.Text())
+		iCtx.invalidOctal = iCtx.value == nil && strings.HasPrefix(iCtx.digits, "0") && strings.ContainsAny(iCtx.digits, "89")
+
+		// negative numbers are wrapped in a unary expression
+		declarator := iCtx.literal.Parent()
+		if declarator.Type() == "unary_expression" {
+			declarator = declarator.Parent()
+		}
+
+		if declarator.Type() == "variable_declarator" {
+			iCtx.declaration = declarator.Parent()
+		}
+
+		m.Context = iCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(integerTooLargeErrorCtx)
+		if ctx.invalidOctal {
+			gen.Add("This error occurs when a whole number starts with `0`, which makes Java read it as an octal (base 8) number that can only contain the digits 0 to 7.")
+			gen.Add(" `%s` contains digits which are not valid in an octal number.", ctx.literal.Text())
+			return
+		}
+
+		gen.Add("This error occurs when a whole number is too large to be an `int`, which can only hold values up to 2147483647. Whole numbers in Java are `int` values unless they end with `L`.")
+		if ctx.value != nil && !numbers.FitsInt64(ctx.value) {
+			gen.Add(" `%s` is even larger than the maximum value of a `long` (9223372036854775807).", ctx.literal.Text())
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(integerTooLargeErrorCtx)
+		if ctx.literal.IsNull() {
+			return
+		}
+
+		if ctx.invalidOctal {
+			gen.Add("Remove the leading zero", func(s *lib.BugFixSuggestion) {
+				newText := strings.TrimLeft(ctx.literal.Text(), "0_")
+				s.AddStep("Remove the `0` at the start of `%s` so that it is read as a decimal number.", ctx.literal.Text()).
+					AddFix(lib.FixSuggestion{
+						NewText:       newText,
+						StartPosition: ctx.literal.StartPosition(),
+						EndPosition:   ctx.literal.EndPosition(),
+					})
+			})
+			return
+		}
+
+		if ctx.value == nil {
+			return
+		}
+
+		if !numbers.FitsInt64(ctx.value) {
+			gen.Add("Use BigInteger instead", func(s *lib.BugFixSuggestion) {
+				step := s.AddStep("Store the number in a `BigInteger`, which can hold whole numbers of any size.")
+				if !ctx.declaration.IsNull() {
+					typeNode := ctx.declaration.ChildByFieldName("type")
+					step.AddFix(lib.FixSuggestion{
+						NewText:       "BigInteger",
+						StartPosition: typeNode.StartPosition(),
+						EndPosition:   typeNode.EndPosition(),
+					})
+				}
+
+				step.AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("new BigInteger(\"%s\")", ctx.value),
+					StartPosition: ctx.literal.StartPosition(),
+					EndPosition:   ctx.literal.EndPosition(),
+				})
+
+				addImportStep(s, cd.MainError.Document, "java.math", "BigInteger")
+			})
+			return
+		}
+
+		suffixFix := lib.FixSuggestion{
+			NewText:       "L",
+			StartPosition: ctx.literal.EndPosition(),
+			EndPosition:   ctx.literal.EndPosition(),
+		}
+
+		if !ctx.declaration.IsNull() && ctx.declaration.ChildByFieldName("type").Text() == "int" {
+			suffixFix.Description = fmt.Sprintf("The `L` at the end of `%s` makes it a `long` value.", ctx.literal.Text())
+			addLongDeclarationFix(gen, ctx.declaration, suffixFix)
+			return
+		}
+
+		gen.Add("Add the L suffix", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Add `L` at the end of `%s` so that it is read as a `long` value.", ctx.literal.Text()).
+				AddFix(suffixFix)
+		})
+	},
+}
+
+// trimIntegerLiteral removes the underscores and the `L` suffix of the literal
+func trimIntegerLiteral(literal string) string {
+	return strings.TrimRight(strings.ReplaceAll(literal, "_", ""), "lL")
+}
@@ -6,6 +6,7 @@ import (
 	"unicode"
 
 	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/error_templates/jvm"
 	"github.com/nedpals/errgoengine/languages/java"
 	sitter "github.com/smacker/go-tree-sitter"
 )
@@ -13,13 +14,17 @@ import (
 func LoadErrorTemplates(errorTemplates *lib.ErrorTemplates) {
 	// Runtime
 	errorTemplates.MustAdd(java.Language, NullPointerException)
-	errorTemplates.MustAdd(java.Language, ArrayIndexOutOfBoundsException)
 	errorTemplates.MustAdd(java.Language, ArithmeticException)
 	errorTemplates.MustAdd(java.Language, NegativeArraySizeException)
 	errorTemplates.MustAdd(java.Language, StringIndexOutOfBoundsException)
 	errorTemplates.MustAdd(java.Language, NoSuchElementException)
 	errorTemplates.MustAdd(java.Language, NumberFormatException)
 	errorTemplates.MustAdd(java.Language, InputMismatchException)
+	errorTemplates.MustAdd(java.Language, ClassCastException)
+	errorTemplates.MustAdd(java.Language, StackOverflowError)
+	errorTemplates.MustAdd(java.Language, IndexOutOfBoundsException)
+	errorTemplates.MustAdd(java.Language, ConcurrentModificationException)
+	errorTemplates.MustAdd(java.Language, UnsupportedOperationException)
 
 	// Compile time
 	errorTemplates.MustAdd(java.Language, PublicClassFilenameMismatchError)
@@ -34,6 +39,7 @@ func LoadErrorTemplates(errorTemplates *lib.ErrorTemplates) {
 	errorTemplates.MustAdd(java.Language, MissingReturnError)
 	errorTemplates.MustAdd(java.Language, NotAStatementError)
 	errorTemplates.MustAdd(java.Language, IncompatibleTypesError)
+	errorTemplates.MustAdd(java.Language, IncomparableTypesError)
 	errorTemplates.MustAdd(java.Language, UninitializedVariableError)
 	errorTemplates.MustAdd(java.Language, AlreadyDefinedError)
 	errorTemplates.MustAdd(java.Language, PrivateAccessError)
@@ -44,20 +50,44 @@ func LoadErrorTemplates(errorTemplates *lib.ErrorTemplates) {
 	errorTemplates.MustAdd(java.Language, IdentifierExpectedError)
 	errorTemplates.MustAdd(java.Language, IllegalCharacterError)
 	errorTemplates.MustAdd(java.Language, CharacterExpectedError)
+	errorTemplates.MustAdd(java.Language, UnreportedExceptionError)
+	errorTemplates.MustAdd(java.Language, AbstractInstantiationError)
+	errorTemplates.MustAdd(java.Language, MissingMethodImplementationError)
+	errorTemplates.MustAdd(java.Language, MethodDoesNotOverrideError)
+	errorTemplates.MustAdd(java.Language, WeakerAccessPrivilegesError)
+	errorTemplates.MustAdd(java.Language, FinalVariableAssignmentError)
+	errorTemplates.MustAdd(java.Language, VariableAlreadyAssignedError)
+	errorTemplates.MustAdd(java.Language, FinalFieldNotInitializedError)
+	errorTemplates.MustAdd(java.Language, ConstructorCannotBeAppliedError)
+	errorTemplates.MustAdd(java.Language, SuperNotFirstStatementError)
+	errorTemplates.MustAdd(java.Language, ElseWithoutIfError)
+	errorTemplates.MustAdd(java.Language, BreakOutsideSwitchOrLoopError)
+	errorTemplates.MustAdd(java.Language, ContinueOutsideLoopError)
+	errorTemplates.MustAdd(java.Language, DuplicateCaseLabelError)
+	errorTemplates.MustAdd(java.Language, ConstantExpressionRequiredError)
+	errorTemplates.MustAdd(java.Language, SwitchNotExhaustiveError)
+	errorTemplates.MustAdd(java.Language, IntegerTooLargeError)
+	errorTemplates.MustAdd(java.Language, MalformedFloatingPointLiteralError)
+	errorTemplates.MustAdd(java.Language, UnexpectedTypeError)
+	errorTemplates.MustAdd(java.Language, GenericArrayCreationError)
+
+	// Shared with the other languages running on the JVM
+	jvm.LoadErrorTemplates(errorTemplates, java.Language, "java")
+
+	// code which compiles may still have a stray semicolon after an if
+	// statement or a loop (eg. `if (x);`) which causes the error at runtime
+	for _, template := range *errorTemplates {
+		if template.Language == java.Language {
+			template.OnGenBugFixFn = withEmptyBodyLint(template.OnGenBugFixFn)
+		}
+	}
 }
 
 func runtimeErrorPattern(errorName string, pattern string) string {
-	p := fmt.Sprintf(
-		`Exception in thread "(?P<thread>\w+)" %s`,
-		strings.ReplaceAll(errorName, ".", `\.`),
-	)
-	if len(pattern) != 0 {
-		p += ": " + pattern
-	}
-	return p
+	return jvm.RuntimeErrorPattern("java", errorName, pattern)
 }
 
-const comptimeStackTracePattern = `(?P<path>\S+):(?P<position>\d+)`
+const comptimeStackTracePattern = `(?P<path>\S+\.java):(?P<position>\d+)`
 
 func comptimeErrorPattern(pattern string, endPattern_ ...string) string {
 	endPattern := ".*"
@@ -86,6 +116,12 @@ func getDefaultValueForType(sym lib.Symbol) string {
 		return "0"
 	case java.BuiltinTypes.FloatingPoint.DoubleSymbol:
 		return "0.0"
+	case java.BuiltinTypes.FloatingPoint.FloatSymbol:
+		return "0.0f"
+	case java.BuiltinTypes.BooleanSymbol:
+		return "false"
+	case java.BuiltinTypes.Integral.CharSymbol:
+		return "' '"
 	case java.BuiltinTypes.StringSymbol:
 		return "\"example\""
 	default:
@@ -93,6 +129,30 @@ func getDefaultValueForType(sym lib.Symbol) string {
 	}
 }
 
+// boxedType returns the wrapper class of the primitive type (eg. `Integer` for `int`)
+func boxedType(sym lib.Symbol) string {
+	switch sym {
+	case java.BuiltinTypes.Integral.ByteSymbol:
+		return "Byte"
+	case java.BuiltinTypes.Integral.ShortSymbol:
+		return "Short"
+	case java.BuiltinTypes.Integral.IntSymbol:
+		return "Integer"
+	case java.BuiltinTypes.Integral.LongSymbol:
+		return "Long"
+	case java.BuiltinTypes.Integral.CharSymbol:
+		return "Character"
+	case java.BuiltinTypes.FloatingPoint.FloatSymbol:
+		return "Float"
+	case java.BuiltinTypes.FloatingPoint.DoubleSymbol:
+		return "Double"
+	case java.BuiltinTypes.BooleanSymbol:
+		return "Boolean"
+	default:
+		return ""
+	}
+}
+
 func symbolToValueNodeType(sym lib.Symbol) []string {
 	switch sym {
 	case java.BuiltinTypes.Integral.IntSymbol:
@@ -128,6 +188,107 @@ func castValueNode(node lib.SyntaxNode, targetSym lib.Symbol) string {
 	}
 }
 
+// nodeOnErrorLine returns the first node with the given tag captured by the query
+// which starts at the line of the error. The parents of the nearest node are
+// also queried since the nearest node may start from the line before the error.
+func nodeOnErrorLine(m *lib.MainError, tag string, query string, d ...any) lib.SyntaxNode {
+	errorLine := m.ErrorNode.StartPos.Line - 1
+	for nearest := m.Nearest; !nearest.IsNull(); nearest = nearest.Parent() {
+		for q := nearest.Query(query, d...); q.Next(); {
+			if q.CurrentTagName() != tag {
+				continue
+			}
+
+			if node := q.CurrentNode(); node.StartPosition().Line == errorLine {
+				return node
+			}
+		}
+	}
+	return lib.SyntaxNode{}
+}
+
+// emptyBody returns the semicolon right after the header of the if statement
+// or loop (eg. `if (x);`) which ends it without a body. This is valid Java but
+// the block after it no longer belongs to the statement.
+func emptyBody(statement lib.SyntaxNode) lib.SyntaxNode {
+	if statement.IsNull() {
+		return lib.SyntaxNode{}
+	}
+
+	field := "body"
+	switch statement.Type() {
+	case "if_statement":
+		field = "consequence"
+	case "for_statement", "enhanced_for_statement", "while_statement":
+	default:
+		return lib.SyntaxNode{}
+	}
+
+	if body := statement.ChildByFieldName(field); body.Type() == ";" {
+		return body
+	}
+	return lib.SyntaxNode{}
+}
+
+// precedingEmptyBody returns the if statement or loop without a body which
+// comes right before the statement (or block) containing the node
+func precedingEmptyBody(node lib.SyntaxNode) (lib.SyntaxNode, lib.SyntaxNode) {
+	for ; !node.IsNull() && node.Type() != "method_declaration" && node.Type() != "constructor_declaration"; node = node.Parent() {
+		if parent := node.Parent(); parent.Type() != "block" && parent.Type() != "constructor_body" {
+			continue
+		}
+
+		statement := node.PrevNamedSibling()
+		if semicolon := emptyBody(statement); !semicolon.IsNull() {
+			return statement, semicolon
+		}
+	}
+	return lib.SyntaxNode{}, lib.SyntaxNode{}
+}
+
+// statementHeader returns the header of the if statement or loop (eg. `if (x)`)
+func statementHeader(statement lib.SyntaxNode) string {
+	header := statement.Text()
+	if body := statement.ChildByFieldName("body"); !body.IsNull() {
+		header = header[:body.StartByte()-statement.StartByte()]
+	} else if consequence := statement.ChildByFieldName("consequence"); !consequence.IsNull() {
+		header = header[:consequence.StartByte()-statement.StartByte()]
+	}
+	return strings.TrimSpace(header)
+}
+
+// addImportStep adds a step for importing the class if the document has not
+// imported it yet. This should be the last step of the suggestion since the
+// new import shifts the lines of the document.
+func addImportStep(s *lib.BugFixSuggestion, doc *lib.Document, pkg string, className string) {
+	importPath := pkg + "." + className
+	rootNode := doc.RootNode()
+	pos := lib.Position{}
+	prefix, suffix := "", "\n\n"
+
+	for q := rootNode.Query(`[(import_declaration) (package_declaration)] @decl`); q.Next(); {
+		node := q.CurrentNode()
+		text := node.Text()
+		if strings.Contains(text, importPath) || strings.Contains(text, pkg+".*") {
+			return
+		}
+
+		pos = node.EndPosition()
+		if node.Type() == "package_declaration" {
+			prefix, suffix = "\n\n", ""
+		} else {
+			prefix, suffix = "\n", ""
+		}
+	}
+
+	s.AddStep("Import `%s` since it is not part of the `java.lang` package.", importPath).
+		AddFix(lib.FixSuggestion{
+			NewText:       prefix + fmt.Sprintf("import %s;", importPath) + suffix,
+			StartPosition: pos,
+			EndPosition:   pos,
+		})
+}
+
 func wrapStatement(s *lib.BugFixStep, header string, footer string, loc lib.Location, withTrailingNewLine bool) lib.Position {
 	line := s.Doc.ModifiedLineAt(loc.StartPos.Line)
 	startCol, endCol := GetSpaceBoundary(line, loc.StartPos.Column, loc.StartPos.Column)
@@ -311,6 +472,41 @@ func indentSpace(spaces string, by int) string {
 	return spaces + indent
 }
 
+// wrapStatements puts the statements from the first one up to the last one
+// inside a block that starts with the header (eg. `try {`) and ends with the
+// footer (eg. `}`). Every line of the statements is indented so that the
+// block stays aligned.
+func wrapStatements(step *lib.BugFixStep, doc *lib.Document, first lib.SyntaxNode, last lib.SyntaxNode, header string, footer string) *lib.BugFixStep {
+	startPos := first.StartPosition()
+	spaces := getSpaceFromBeginning(doc, startPos.Line, startPos.Column)
+	indent := getIndent(spaces, 1)
+
+	if startPos.Line == last.EndPosition().Line {
+		return step.AddFix(lib.FixSuggestion{
+			NewText:       header + "\n" + spaces + indent,
+			StartPosition: startPos,
+			EndPosition:   startPos,
+		}).AddFix(lib.FixSuggestion{
+			NewText:       "\n" + spaces + footer,
+			StartPosition: last.EndPosition(),
+			EndPosition:   last.EndPosition(),
+		})
+	}
+
+	lines := strings.Split(doc.Contents[first.StartByte():last.EndByte()], "\n")
+	for i := 1; i < len(lines); i++ {
+		if len(strings.TrimSpace(lines[i])) != 0 {
+			lines[i] = indent + lines[i]
+		}
+	}
+
+	return step.AddFix(lib.FixSuggestion{
+		NewText:       fmt.Sprintf("%s\n%s%s%s\n%s%s", header, spaces, indent, strings.Join(lines, "\n"), spaces, footer),
+		StartPosition: startPos,
+		EndPosition:   last.EndPosition(),
+	})
+}
+
 func nearestMissingNodeFromPos(cursor *sitter.TreeCursor, pos lib.Position) *sitter.Node {
 	defer cursor.GoToParent()
 
@@ -1,10 +1,14 @@
 package java_test
 
 import (
+	"strings"
 	"testing"
 
+	lib "github.com/nedpals/errgoengine"
 	"github.com/nedpals/errgoengine/error_templates/java"
 	testutils "github.com/nedpals/errgoengine/error_templates/test_utils"
+	javaLang "github.com/nedpals/errgoengine/languages/java"
+	sitter "github.com/smacker/go-tree-sitter"
 )
 
 func TestGetSpaceBoundary(t *testing.T) {
@@ -46,3 +50,49 @@ func TestJavaErrorTemplates(t *testing.T) {
 		TemplateLoader: java.LoadErrorTemplates,
 	}).Execute(t)
 }
+
+func TestLintEmptyBodies(t *testing.T) {
+	parser := sitter.NewParser()
+	doc, err := lib.ParseDocument("Main.java", strings.NewReader(`public class Main {
+    public static void main(String[] args) {
+        int x = 5;
+        if (x > 10); {
+            System.out.println("big");
+        }
+        for (int i = 0; i < 3; i++);
+        while (x > 0) {
+            x--;
+        }
+    }
+}`), parser, javaLang.Language, nil)
+	if err != nil {
+		t.Fatalf("Error parsing document: %s", err)
+	}
+
+	gen := lib.NewBugFixGenerator(doc)
+	java.LintEmptyBodies(gen)
+
+	expected := []struct {
+		title string
+		line  string
+	}{
+		{"Remove the semicolon after `if (x > 10)`", "        if (x > 10) {"},
+		{"Remove the semicolon after `for (int i = 0; i < 3; i++)`", "        for (int i = 0; i < 3; i++)"},
+	}
+
+	if len(gen.Suggestions) != len(expected) {
+		t.Fatalf("Expected %d suggestions, got %d", len(expected), len(gen.Suggestions))
+	}
+
+	for i, exp := range expected {
+		suggestion := gen.Suggestions[i]
+		if suggestion.Title != exp.title {
+			t.Errorf("Expected %q, got %q", exp.title, suggestion.Title)
+		}
+
+		step := suggestion.Steps[0]
+		if line := step.Doc.ModifiedLineAt(step.Fixes[0].StartPosition.Line); line != exp.line {
+			t.Errorf("Expected %q, got %q", exp.line, line)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package java
+
+import (
+	"regexp"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+// matches a number whose exponent has no digits (eg. `1.5e`, `2E+`)
+var incompleteExponentPattern = regexp.MustCompile(`(?:\b\d[\d_]*(?:\.[\d_]*)?|\.\d[\d_]*)([eE][+-]?)(?:[^\w+-]|$)`)
+
+type malformedFloatingPointLiteralErrorCtx struct {
+	// the number including the incomplete exponent
+	literal       string
+	literalPos    lib.Position
+	exponentStart lib.Position
+	exponentEnd   lib.Position
+}
+
+var MalformedFloatingPointLiteralError = lib.ErrorTemplate{
+	Name:              "MalformedFloatingPointLiteralError",
+	Pattern:           comptimeErrorPattern(`malformed floating-point literal`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		mCtx := malformedFloatingPointLiteralErrorCtx{}
+		line := m.ErrorNode.StartPos.Line - 1
+		matches := incompleteExponentPattern.FindStringSubmatchIndex(m.Document.LineAt(line))
+		if matches == nil {
+			m.Context = mCtx
+			return
+		}
+
+		mCtx.literal = m.Document.LineAt(line)[matches[0]:matches[3]]
+		mCtx.literalPos = lib.Position{Line: line, Column: matches[0]}
+		mCtx.exponentStart = lib.Position{Line: line, Column: matches[2]}
+		mCtx.exponentEnd = lib.Position{Line: line, Column: matches[3]}
+
+		// the exponent is not part of the literal since it cannot be parsed
+		m.Nearest = m.Document.RootNode().NamedDescendantForPointRange(lib.Location{
+			StartPos: mCtx.literalPos,
+			EndPos:   mCtx.exponentStart,
+		})
+		m.Context = mCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(malformedFloatingPointLiteralErrorCtx)
+		gen.Add("This error occurs when a decimal number is not written in a format that Java understands.")
+		if len(ctx.literal) == 0 {
+			return
+		}
+
+		gen.Add(
+			" `%s` uses scientific notation but has no digits after the `e`. The exponent needs a whole number, such as `%s3` which means %s × 10³.",
+			ctx.literal, ctx.literal, ctx.literal[:ctx.exponentStart.Column-ctx.literalPos.Column],
+		)
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(malformedFloatingPointLiteralErrorCtx)
+		if len(ctx.literal) == 0 {
+			return
+		}
+
+		gen.Add("Complete the exponent", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Add the digits of the exponent after `%s`.", ctx.literal).
+				AddFix(lib.FixSuggestion{
+					NewText:       "0",
+					StartPosition: ctx.exponentEnd,
+					EndPosition:   ctx.exponentEnd,
+					Description:   "Replace `0` with the power of 10 that the number should be multiplied with.",
+				})
+		})
+
+		gen.Add("Remove the exponent", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Remove the incomplete exponent if the number does not need scientific notation.").
+				AddFix(lib.FixSuggestion{
+					NewText:       "",
+					StartPosition: ctx.exponentStart,
+					EndPosition:   ctx.exponentEnd,
+				})
+		})
+	},
+}
@@ -0,0 +1,170 @@
+package java
+
+import (
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/utils/levenshtein"
+)
+
+// methods of Object which are commonly overridden, in the format of name and parameters
+var objectMethods = [][2]string{
+	{"toString", "()"},
+	{"equals", "(Object obj)"},
+	{"hashCode", "()"},
+}
+
+type methodDoesNotOverrideErrorCtx struct {
+	annotation lib.SyntaxNode
+	method     lib.SyntaxNode
+	// the method of the supertype which is the closest to the annotated method
+	candidateName       string
+	candidateParameters string
+	candidateOwner      string
+}
+
+var MethodDoesNotOverrideError = lib.ErrorTemplate{
+	Name:              "MethodDoesNotOverrideError",
+	Pattern:           comptimeErrorPattern(`method does not override or implement a method from a supertype`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		mCtx := methodDoesNotOverrideErrorCtx{}
+		mCtx.annotation = nodeOnErrorLine(m, "annotation", `((marker_annotation name: (identifier) @name) @annotation (#eq? @name "Override"))`)
+		if mCtx.annotation.IsNull() {
+			m.Context = mCtx
+			return
+		}
+
+		// annotation -> modifiers -> method_declaration
+		mCtx.method = mCtx.annotation.Parent().Parent()
+		m.Nearest = mCtx.method.ChildByFieldName("name")
+
+		class := mCtx.method.Parent().Parent()
+		name := mCtx.method.ChildByFieldName("name").Text()
+		paramsCount := mCtx.method.ChildByFieldName("parameters").NamedChildCount()
+		tree := cd.InitOrGetSymbolTree(cd.MainDocumentPath())
+		minDistance := -1
+
+		consider := func(candidateName string, parameters string, owner string) {
+			distance := levenshtein.ComputeDistance(strings.ToLower(name), strings.ToLower(candidateName))
+			// prefer methods with the same number of parameters
+			if (parameters == "()" && paramsCount != 0) || (parameters != "()" && strings.Count(parameters, ",")+1 != int(paramsCount)) {
+				distance++
+			}
+
+			if minDistance == -1 || distance < minDistance {
+				minDistance = distance
+				mCtx.candidateName = candidateName
+				mCtx.candidateParameters = parameters
+				mCtx.candidateOwner = owner
+			}
+		}
+
+		// go through the methods of all the supertypes declared in the document
+		visited := map[string]bool{}
+		supertypes := supertypesOf(class)
+		for len(supertypes) != 0 {
+			supertype := supertypes[0]
+			supertypes = supertypes[1:]
+			if visited[supertype] {
+				continue
+			}
+
+			visited[supertype] = true
+			decl := findTypeDeclaration(m.Document, tree, supertype)
+			if decl.IsNull() {
+				continue
+			}
+
+			body := decl.ChildByFieldName("body")
+			for i := 0; i < int(body.NamedChildCount()); i++ {
+				member := body.NamedChild(i)
+				if member.Type() != "method_declaration" || hasModifier(member, "private") || hasModifier(member, "static") {
+					continue
+				}
+
+				consider(member.ChildByFieldName("name").Text(), member.ChildByFieldName("parameters").Text(), supertype)
+			}
+
+			supertypes = append(supertypes, supertypesOf(decl)...)
+		}
+
+		for _, method := range objectMethods {
+			consider(method[0], method[1], "Object")
+		}
+
+		// ignore methods which are too different from the annotated method
+		if minDistance > len(name)/2 {
+			mCtx.candidateName = ""
+		}
+
+		m.Context = mCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(methodDoesNotOverrideErrorCtx)
+		gen.Add("This error occurs when a method is marked with `@Override` but its superclass or interfaces do not have a method with the same name and parameters.")
+
+		if ctx.method.IsNull() || len(ctx.candidateName) == 0 {
+			return
+		}
+
+		name := ctx.method.ChildByFieldName("name").Text()
+		if name == ctx.candidateName {
+			gen.Add(" The `%s` method of `%s` has different parameters, so `%s` here is treated as a new method instead.", name, ctx.candidateOwner, name)
+		} else {
+			gen.Add(" `%s` looks similar to the `%s` method of `%s`, but their names do not match.", name, ctx.candidateName, ctx.candidateOwner)
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(methodDoesNotOverrideErrorCtx)
+		if ctx.annotation.IsNull() {
+			return
+		}
+
+		nameNode := ctx.method.ChildByFieldName("name")
+		parameters := ctx.method.ChildByFieldName("parameters")
+
+		if len(ctx.candidateName) != 0 && nameNode.Text() != ctx.candidateName {
+			gen.Add("Rename the method", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Use the same name as the `%s` method of `%s`.", ctx.candidateName, ctx.candidateOwner).
+					AddFix(lib.FixSuggestion{
+						NewText:       ctx.candidateName,
+						StartPosition: nameNode.StartPosition(),
+						EndPosition:   nameNode.EndPosition(),
+					})
+			})
+		} else if len(ctx.candidateName) != 0 && parameters.Text() != ctx.candidateParameters {
+			gen.Add("Use the same parameters", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Change the parameters of `%s` to match the method of `%s`.", nameNode.Text(), ctx.candidateOwner).
+					AddFix(lib.FixSuggestion{
+						NewText:       ctx.candidateParameters,
+						StartPosition: parameters.StartPosition(),
+						EndPosition:   parameters.EndPosition(),
+						Description:   "Update the body of the method to use the new parameters.",
+					})
+			})
+		}
+
+		gen.Add("Remove the @Override annotation", func(s *lib.BugFixSuggestion) {
+			startPos := ctx.annotation.StartPosition()
+			endPos := ctx.annotation.EndPosition()
+			line := cd.MainError.Document.LineAt(startPos.Line)
+
+			// remove the entire line if the annotation is on its own line
+			if strings.TrimSpace(line) == ctx.annotation.Text() {
+				startPos = lib.Position{Line: startPos.Line}
+				endPos = lib.Position{Line: endPos.Line, Column: len(line)}
+			} else {
+				endPos.Column++
+				endPos.Index++
+			}
+
+			s.AddStep("If `%s` is a new method, remove the `@Override` annotation since it does not override anything.", nameNode.Text()).
+				AddFix(lib.FixSuggestion{
+					NewText:       "",
+					StartPosition: startPos,
+					EndPosition:   endPos,
+				})
+		})
+	},
+}
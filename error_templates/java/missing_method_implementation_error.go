@@ -0,0 +1,294 @@
+package java
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type missingMethodImplementationErrorCtx struct {
+	class  lib.SyntaxNode
+	parent lib.SyntaxNode
+	// abstract methods of the parent which are not implemented by the class
+	missingMethods []lib.SyntaxNode
+}
+
+var MissingMethodImplementationError = lib.ErrorTemplate{
+	Name:              "MissingMethodImplementationError",
+	Pattern:           comptimeErrorPattern(`(?P<class>\S+) is not abstract and does not override abstract method (?P<method>[^\s(]+)\((?P<parameters>[^)]*)\) in (?P<parent>\S+)`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		mCtx := missingMethodImplementationErrorCtx{}
+		tree := cd.InitOrGetSymbolTree(cd.MainDocumentPath())
+
+		mCtx.class = findTypeDeclaration(m.Document, tree, simpleClassName(cd.Variables["class"]))
+		mCtx.parent = findTypeDeclaration(m.Document, tree, simpleClassName(cd.Variables["parent"]))
+		if mCtx.class.IsNull() {
+			m.Context = mCtx
+			return
+		}
+
+		m.Nearest = mCtx.class.ChildByFieldName("name")
+		if !mCtx.parent.IsNull() {
+			for _, method := range abstractMethodsOf(mCtx.parent) {
+				if !hasMethod(mCtx.class, method) {
+					mCtx.missingMethods = append(mCtx.missingMethods, method)
+				}
+			}
+		}
+
+		m.Context = mCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(missingMethodImplementationErrorCtx)
+		className := simpleClassName(cd.Variables["class"])
+		parentName := simpleClassName(cd.Variables["parent"])
+		gen.Add("This error occurs when a class extends an abstract class or implements an interface without providing the methods that it requires. ")
+
+		if len(ctx.missingMethods) > 1 {
+			signatures := make([]string, len(ctx.missingMethods))
+			for i, method := range ctx.missingMethods {
+				signatures[i] = fmt.Sprintf("`%s`", methodSignature(method))
+			}
+
+			gen.Add("`%s` does not implement the following methods of `%s`: %s.", className, parentName, strings.Join(signatures, ", "))
+			return
+		}
+
+		gen.Add("`%s` does not implement the `%s(%s)` method of `%s`.", className, cd.Variables["method"], cd.Variables["parameters"], parentName)
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(missingMethodImplementationErrorCtx)
+		if ctx.class.IsNull() {
+			return
+		}
+
+		doc := cd.MainError.Document
+		className := simpleClassName(cd.Variables["class"])
+
+		if len(ctx.missingMethods) != 0 {
+			gen.Add("Implement the missing methods", func(s *lib.BugFixSuggestion) {
+				body := ctx.class.ChildByFieldName("body")
+				indent := memberIndentation(doc, ctx.class)
+				stubs := &strings.Builder{}
+
+				// add the methods after the last member of the class
+				insertPos := body.StartPosition()
+				insertPos.Column++
+				insertPos.Index++
+				if body.NamedChildCount() != 0 {
+					insertPos = body.LastNamedChild().EndPosition()
+				}
+
+				for i, method := range ctx.missingMethods {
+					// separate the methods from the existing members of the class
+					if body.NamedChildCount() != 0 || i != 0 {
+						stubs.WriteByte('\n')
+					}
+					stubs.WriteString("\n" + methodStub(cd, method, ctx.parent.Type() == "interface_declaration", indent))
+				}
+
+				s.AddStep("Add the methods required by `%s` to `%s`.", simpleClassName(cd.Variables["parent"]), className).
+					AddFix(lib.FixSuggestion{
+						NewText:       stubs.String(),
+						StartPosition: insertPos,
+						EndPosition:   insertPos,
+						Description:   "Replace the returned values with the actual implementation of each method.",
+					})
+			})
+		}
+
+		if classKeyword := childOfType(ctx.class, "class"); !classKeyword.IsNull() {
+			gen.Add("Declare the class as abstract", func(s *lib.BugFixSuggestion) {
+				s.AddStep("If `%s` is not meant to be instantiated, mark it as `abstract` so that its subclasses implement the methods instead.", className).
+					AddFix(lib.FixSuggestion{
+						NewText:       "abstract ",
+						StartPosition: classKeyword.StartPosition(),
+						EndPosition:   classKeyword.StartPosition(),
+					})
+			})
+		}
+	},
+}
+
+// findTypeDeclaration returns the declaration of the class or interface with the given name
+func findTypeDeclaration(doc *lib.Document, tree *lib.SymbolTree, name string) lib.SyntaxNode {
+	if classNode := findClassDeclaration(doc, tree, name); !classNode.IsNull() {
+		return classNode
+	}
+
+	// interfaces are not included in the symbol tree
+	for q := doc.RootNode().Query(`(interface_declaration name: (identifier) @name (#eq? @name "%s")) @interface`, name); q.Next(); {
+		if q.CurrentTagName() == "interface" {
+			return q.CurrentNode()
+		}
+	}
+
+	return lib.SyntaxNode{}
+}
+
+// supertypesOf returns the names of the superclass and the interfaces of a class or interface declaration
+func supertypesOf(decl lib.SyntaxNode) []string {
+	supertypes := []string{}
+	if superclass := decl.ChildByFieldName("superclass"); !superclass.IsNull() {
+		supertypes = append(supertypes, typeName(superclass.LastNamedChild()))
+	}
+
+	typeLists := []lib.SyntaxNode{decl.ChildByFieldName("interfaces"), childOfType(decl, "extends_interfaces")}
+	for _, typeList := range typeLists {
+		if typeList.IsNull() {
+			continue
+		}
+
+		types := typeList.LastNamedChild()
+		for i := 0; i < int(types.NamedChildCount()); i++ {
+			supertypes = append(supertypes, typeName(types.NamedChild(i)))
+		}
+	}
+
+	return supertypes
+}
+
+// typeName returns the name of the type without its type arguments (eg. `List` in `List<String>`)
+func typeName(typ lib.SyntaxNode) string {
+	if typ.Type() == "generic_type" {
+		typ = typ.FirstNamedChild()
+	}
+	return simpleClassName(typ.Text())
+}
+
+// abstractMethodsOf returns the methods of the class or interface declaration that has no implementation
+func abstractMethodsOf(decl lib.SyntaxNode) []lib.SyntaxNode {
+	methods := []lib.SyntaxNode{}
+	isInterface := decl.Type() == "interface_declaration"
+	body := decl.ChildByFieldName("body")
+
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		method := body.NamedChild(i)
+		if method.Type() != "method_declaration" {
+			continue
+		}
+
+		if hasModifier(method, "abstract") ||
+			(isInterface && method.ChildByFieldName("body").IsNull() && !hasModifier(method, "static") && !hasModifier(method, "private")) {
+			methods = append(methods, method)
+		}
+	}
+
+	return methods
+}
+
+// hasMethod checks if the class declares a method with the same name and number of parameters
+func hasMethod(decl lib.SyntaxNode, method lib.SyntaxNode) bool {
+	body := decl.ChildByFieldName("body")
+	name := method.ChildByFieldName("name").Text()
+	paramsCount := method.ChildByFieldName("parameters").NamedChildCount()
+
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		member := body.NamedChild(i)
+		if member.Type() == "method_declaration" &&
+			member.ChildByFieldName("name").Text() == name &&
+			member.ChildByFieldName("parameters").NamedChildCount() == paramsCount {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasModifier(decl lib.SyntaxNode, modifier string) bool {
+	modifiers := childOfType(decl, "modifiers")
+	return !modifiers.IsNull() && !childOfType(modifiers, modifier).IsNull()
+}
+
+func childOfType(node lib.SyntaxNode, typ string) lib.SyntaxNode {
+	for i := 0; i < int(node.ChildCount()); i++ {
+		if child := node.Child(i); child.Type() == typ {
+			return child
+		}
+	}
+	return lib.SyntaxNode{}
+}
+
+// methodSignature returns the signature of the method in the format used by javac (eg. `area(double,int)`)
+func methodSignature(method lib.SyntaxNode) string {
+	parameters := method.ChildByFieldName("parameters")
+	types := make([]string, parameters.NamedChildCount())
+	for i := range types {
+		types[i] = parameters.NamedChild(i).ChildByFieldName("type").Text()
+	}
+	return fmt.Sprintf("%s(%s)", method.ChildByFieldName("name").Text(), strings.Join(types, ","))
+}
+
+// memberIndentation returns the indentation used by the members of the class or interface
+func memberIndentation(doc *lib.Document, decl lib.SyntaxNode) string {
+	body := decl.ChildByFieldName("body")
+	if body.NamedChildCount() != 0 {
+		member := body.FirstNamedChild().StartPosition()
+		return getSpaceFromBeginning(doc, member.Line, member.Column)
+	}
+
+	spaces := getSpaceFromBeginning(doc, decl.StartPosition().Line, decl.StartPosition().Column)
+	return spaces + "    "
+}
+
+// insertMemberAfter returns a fix which adds the member (already indented) after
+// the given member of a class, separated from it by a blank line
+func insertMemberAfter(doc *lib.Document, after lib.SyntaxNode, member string) lib.FixSuggestion {
+	endPos := after.EndPosition()
+
+	// reuse the blank line after the member if there is one so that the member
+	// is placed between the blank line and the next member
+	if endPos.Line+2 < doc.TotalLines() &&
+		len(strings.TrimSpace(doc.LineAt(endPos.Line)[endPos.Column:])) == 0 &&
+		len(strings.TrimSpace(doc.LineAt(endPos.Line+1))) == 0 {
+		insertPos := lib.Position{Line: endPos.Line + 2}
+		return lib.FixSuggestion{
+			NewText:       member + "\n\n",
+			StartPosition: insertPos,
+			EndPosition:   insertPos,
+		}
+	}
+
+	return lib.FixSuggestion{
+		NewText:       "\n\n" + member,
+		StartPosition: endPos,
+		EndPosition:   endPos,
+	}
+}
+
+// methodStub generates an implementation of the abstract method which returns
+// the default value of its return type
+func methodStub(cd *lib.ContextData, method lib.SyntaxNode, fromInterface bool, indent string) string {
+	modifiers := []string{}
+	if modifiersNode := childOfType(method, "modifiers"); !modifiersNode.IsNull() {
+		for i := 0; i < int(modifiersNode.ChildCount()); i++ {
+			modifier := modifiersNode.Child(i)
+			if modifier.Type() == "abstract" || strings.HasSuffix(modifier.Type(), "annotation") {
+				continue
+			}
+			modifiers = append(modifiers, modifier.Text())
+		}
+	}
+
+	// interface methods are implicitly public
+	if fromInterface && len(modifiers) == 0 {
+		modifiers = append(modifiers, "public")
+	}
+
+	returnType := method.ChildByFieldName("type")
+	header := fmt.Sprintf("%s %s%s", returnType.Text(), method.ChildByFieldName("name").Text(), method.ChildByFieldName("parameters").Text())
+	if len(modifiers) != 0 {
+		header = strings.Join(modifiers, " ") + " " + header
+	}
+
+	lines := []string{indent + "@Override", indent + header + " {"}
+	if returnType.Type() != "void_type" {
+		returnTypeSym := cd.Analyzer.AnalyzeNode(context.Background(), returnType)
+		lines = append(lines, fmt.Sprintf("%s%sreturn %s;", indent, getIndent(indent, 1), getDefaultValueForType(returnTypeSym)))
+	}
+	lines = append(lines, indent+"}")
+	return strings.Join(lines, "\n")
+}
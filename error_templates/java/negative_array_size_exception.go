@@ -1,11 +1,16 @@
 package java
 
 import (
+	"fmt"
+
 	lib "github.com/nedpals/errgoengine"
 )
 
 type negativeArraySizeExceptionCtx struct {
 	ArrayExprNode lib.SyntaxNode
+	// the multiplication which overflowed into a negative size
+	overflow    intOverflow
+	hasOverflow bool
 }
 
 var NegativeArraySizeException = lib.ErrorTemplate{
@@ -21,12 +26,49 @@ var NegativeArraySizeException = lib.ErrorTemplate{
 			break
 		}
 
+		if nCtx.ArrayExprNode.IsNull() {
+			// sizes which are too large for an int wrap around to a negative number
+			for q := m.Nearest.Query("(array_creation_expression dimensions: (dimensions_expr) @size)"); q.Next(); {
+				size := q.CurrentNode().NamedChild(0)
+				if overflow, ok := findIntOverflow(cd, m.Document, size); ok {
+					nCtx.ArrayExprNode = q.CurrentNode().Parent()
+					nCtx.overflow = overflow
+					nCtx.hasOverflow = true
+					m.Nearest = size
+					break
+				}
+			}
+		}
+
 		m.Context = nCtx
 	},
 	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(negativeArraySizeExceptionCtx)
 		gen.Add("This error occurs when you try to create an array with a negative size.")
+		if ctx.hasOverflow {
+			gen.Add(
+				" The size comes from `%s`, which is %s. This is larger than the maximum value of an `int` (2147483647), so the multiplication overflows and wraps around to %d.",
+				ctx.overflow.expression.Text(), ctx.overflow.value, ctx.overflow.wrapped,
+			)
+		}
 	},
 	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(negativeArraySizeExceptionCtx)
+		if ctx.hasOverflow {
+			gen.Add("Detect the overflow", func(s *lib.BugFixSuggestion) {
+				left := ctx.overflow.expression.ChildByFieldName("left")
+				right := ctx.overflow.expression.ChildByFieldName("right")
+				s.AddStep("Use `Math.multiplyExact` to compute `%s` so that the overflow is reported instead of producing a negative size.", ctx.overflow.expression.Text()).
+					AddFix(lib.FixSuggestion{
+						NewText:       fmt.Sprintf("Math.multiplyExact(%s, %s)", left.Text(), right.Text()),
+						StartPosition: ctx.overflow.expression.StartPosition(),
+						EndPosition:   ctx.overflow.expression.EndPosition(),
+						Description:   "An array cannot hold more than 2147483647 elements, so the size itself needs to be reduced if the values are correct.",
+					})
+			})
+			return
+		}
+
 		gen.Add("Ensure a non-negative array size", func(s *lib.BugFixSuggestion) {
 			s.AddStep("Make sure the array size is non-negative").
 				AddFix(lib.FixSuggestion{
@@ -0,0 +1,127 @@
+package java
+
+import (
+	"math/big"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/utils/numbers"
+)
+
+// intOverflow is an `int` multiplication whose result does not fit in an `int`
+type intOverflow struct {
+	// the multiplication which overflows
+	expression lib.SyntaxNode
+	// the declaration of the variable which stores the result, if any
+	declaration lib.SyntaxNode
+	// the actual result of the multiplication
+	value *big.Int
+	// the result after it wraps around
+	wrapped int32
+}
+
+// findIntOverflow checks if the value of the expression comes from an `int`
+// multiplication which overflows. Variables are followed to the value given
+// to them in their declaration.
+func findIntOverflow(cd *lib.ContextData, doc *lib.Document, expr lib.SyntaxNode) (intOverflow, bool) {
+	result := intOverflow{expression: expr}
+	for result.expression.Type() == "parenthesized_expression" {
+		result.expression = result.expression.NamedChild(0)
+	}
+
+	if result.expression.Type() == "identifier" {
+		result.declaration = findVariableDeclaration(cd, doc, result.expression, result.expression.Text())
+		result.expression = declaredValue(result.declaration, result.expression.Text())
+	}
+
+	if result.expression.IsNull() || result.expression.Type() != "binary_expression" ||
+		result.expression.ChildByFieldName("operator").Text() != "*" {
+		return result, false
+	}
+
+	value, ok := evaluateInt(cd, doc, result.expression, 0)
+	if !ok || numbers.FitsInt32(value) {
+		return result, false
+	}
+
+	result.value = value
+	result.wrapped = numbers.WrapInt32(value)
+	return result, true
+}
+
+// evaluateInt computes the exact value of an `int` expression made of
+// literals, variables and basic arithmetic operators
+func evaluateInt(cd *lib.ContextData, doc *lib.Document, node lib.SyntaxNode, depth int) (*big.Int, bool) {
+	// avoid following variables which refer to each other forever
+	if node.IsNull() || depth > 10 {
+		return nil, false
+	}
+
+	switch node.Type() {
+	case "decimal_integer_literal", "hex_integer_literal", "octal_integer_literal", "binary_integer_literal":
+		value, isLong, ok := numbers.ParseInteger(node.Text())
+		return value, ok && !isLong
+	case "parenthesized_expression":
+		return evaluateInt(cd, doc, node.NamedChild(0), depth+1)
+	case "unary_expression":
+		if node.ChildByFieldName("operator").Text() != "-" {
+			return nil, false
+		}
+
+		value, ok := evaluateInt(cd, doc, node.ChildByFieldName("operand"), depth+1)
+		if !ok {
+			return nil, false
+		}
+		return new(big.Int).Neg(value), true
+	case "identifier":
+		declaration := findVariableDeclaration(cd, doc, node, node.Text())
+		if declaration.IsNull() || declaration.ChildByFieldName("type").Text() != "int" {
+			return nil, false
+		}
+		return evaluateInt(cd, doc, declaredValue(declaration, node.Text()), depth+1)
+	case "binary_expression":
+		left, ok := evaluateInt(cd, doc, node.ChildByFieldName("left"), depth+1)
+		if !ok {
+			return nil, false
+		}
+
+		right, ok := evaluateInt(cd, doc, node.ChildByFieldName("right"), depth+1)
+		if !ok {
+			return nil, false
+		}
+
+		switch node.ChildByFieldName("operator").Text() {
+		case "*":
+			return new(big.Int).Mul(left, right), true
+		case "+":
+			return new(big.Int).Add(left, right), true
+		case "-":
+			return new(big.Int).Sub(left, right), true
+		}
+	}
+	return nil, false
+}
+
+// declaredValue returns the value given to the variable in its declaration
+func declaredValue(declaration lib.SyntaxNode, name string) lib.SyntaxNode {
+	if declaration.IsNull() {
+		return lib.SyntaxNode{}
+	}
+
+	for q := declaration.Query(`(variable_declarator name: (identifier) @name value: (_) @value (#eq? @name "%s"))`, name); q.Next(); {
+		if q.CurrentTagName() == "value" {
+			return q.CurrentNode()
+		}
+	}
+	return lib.SyntaxNode{}
+}
+
+// useLongMultiplicationFix casts the first operand of the multiplication to
+// `long` so that the multiplication is done with `long` values
+func useLongMultiplicationFix(overflow intOverflow) lib.FixSuggestion {
+	left := overflow.expression.ChildByFieldName("left")
+	return lib.FixSuggestion{
+		NewText:       "(long) ",
+		StartPosition: left.StartPosition(),
+		EndPosition:   left.StartPosition(),
+	}
+}
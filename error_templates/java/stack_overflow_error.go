@@ -0,0 +1,327 @@
+package java
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/languages/java"
+)
+
+type recursionProblemKind int
+
+const (
+	recursionUnknown recursionProblemKind = iota
+	recursionWithoutBaseCase
+	recursionWithSameArguments
+	recursionAwayFromBaseCase
+)
+
+type stackOverflowErrorCtx struct {
+	kind       recursionProblemKind
+	methodName string
+	// the method called by the recursive call, which is the method itself
+	// unless the methods call each other (eg. `a` calls `b` which calls `a`)
+	calleeName string
+	// the names of the methods in the order they call each other
+	cycle  []string
+	method lib.SyntaxNode
+	call   lib.SyntaxNode
+	// the argument of the recursive call passed to the parameter checked by the base case
+	argument  lib.SyntaxNode
+	parameter string
+	// whether the base case is reached by decreasing the parameter (eg. `n == 0` or `n <= 1`)
+	decreasing bool
+}
+
+var StackOverflowError = lib.ErrorTemplate{
+	Name:    "StackOverflowError",
+	Pattern: runtimeErrorPattern("java.lang.StackOverflowError", ""),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		sCtx := stackOverflowErrorCtx{decreasing: true}
+		cycle := cd.TraceStack.Cycle()
+		if len(cycle) == 0 {
+			m.Context = sCtx
+			return
+		}
+
+		// the first frame of the cycle points to the recursive call while the
+		// called method is found in the last frame of the cycle
+		frame := cycle[0]
+		sCtx.methodName = frameMethodName(frame)
+		sCtx.calleeName = frameMethodName(cycle[len(cycle)-1])
+		calleeName := sCtx.calleeName
+		rootNode := m.Document.RootNode()
+
+		// each frame is called by the frame below it
+		sCtx.cycle = append(sCtx.cycle, sCtx.methodName)
+		for i := len(cycle) - 1; i > 0; i-- {
+			sCtx.cycle = append(sCtx.cycle, frameMethodName(cycle[i]))
+		}
+
+		sCtx.method = methodOfFrame(rootNode, frame)
+		if sCtx.method.IsNull() {
+			m.Context = sCtx
+			return
+		}
+
+		for q := sCtx.method.Query(`(method_invocation name: (identifier) @name (#eq? @name "%s")) @call`, calleeName); q.Next(); {
+			if q.CurrentTagName() != "call" {
+				continue
+			}
+
+			node := q.CurrentNode()
+			if node.StartPosition().Line+1 == frame.StartPos.Line {
+				sCtx.call = node
+				m.Nearest = node
+				break
+			}
+		}
+
+		// the arguments of methods which call each other cannot be compared
+		// with the parameters of the base case so only its presence is checked
+		if len(cycle) > 1 {
+			sCtx.kind = recursionWithoutBaseCase
+			for _, frame := range cycle {
+				if !findBaseCase(methodOfFrame(rootNode, frame), sCtx.cycle).IsNull() {
+					sCtx.kind = recursionUnknown
+					break
+				}
+			}
+			m.Context = sCtx
+			return
+		}
+
+		baseCase := findBaseCase(sCtx.method, []string{calleeName})
+		if baseCase.IsNull() {
+			sCtx.kind = recursionWithoutBaseCase
+			m.Context = sCtx
+			return
+		} else if sCtx.call.IsNull() {
+			m.Context = sCtx
+			return
+		}
+
+		// find the parameter checked by the base case (eg. `n` in `n == 0`)
+		parameters := sCtx.method.ChildByFieldName("parameters")
+		paramIdx := 0
+		for q := baseCase.ChildByFieldName("condition").Query(`(binary_expression left: (identifier) @left operator: _ @operator)`); q.Next(); {
+			if q.CurrentTagName() == "operator" {
+				operator := q.CurrentNode().Type()
+				sCtx.decreasing = operator == "==" || operator == "<" || operator == "<="
+				break
+			}
+
+			for i := 0; i < int(parameters.NamedChildCount()); i++ {
+				if parameters.NamedChild(i).ChildByFieldName("name").Text() == q.CurrentNode().Text() {
+					paramIdx = i
+				}
+			}
+		}
+
+		arguments := sCtx.call.ChildByFieldName("arguments")
+		if paramIdx >= int(parameters.NamedChildCount()) || paramIdx >= int(arguments.NamedChildCount()) {
+			m.Context = sCtx
+			return
+		}
+
+		sCtx.parameter = parameters.NamedChild(paramIdx).ChildByFieldName("name").Text()
+		sCtx.argument = arguments.NamedChild(paramIdx)
+
+		if sCtx.argument.Text() == sCtx.parameter {
+			sCtx.kind = recursionWithSameArguments
+		} else if sCtx.argument.Type() == "binary_expression" && sCtx.argument.ChildByFieldName("left").Text() == sCtx.parameter {
+			operator := sCtx.argument.ChildByFieldName("operator").Type()
+			if (sCtx.decreasing && operator == "+") || (!sCtx.decreasing && operator == "-") {
+				sCtx.kind = recursionAwayFromBaseCase
+			}
+		}
+
+		m.Context = sCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(stackOverflowErrorCtx)
+		if len(ctx.cycle) > 1 {
+			gen.Add("This error occurs when methods keep calling each other until the program runs out of stack memory.")
+			if ctx.kind == recursionWithoutBaseCase {
+				gen.Add(" %s, and none of them has a base case that stops the recursion.", callChain(ctx.cycle))
+			} else {
+				gen.Add(" %s without ever reaching a base case.", callChain(ctx.cycle))
+			}
+			return
+		}
+
+		gen.Add("This error occurs when a method keeps calling itself until the program runs out of stack memory.")
+
+		switch ctx.kind {
+		case recursionWithoutBaseCase:
+			gen.Add(" The `%s` method calls itself without a base case that stops the recursion.", ctx.methodName)
+		case recursionWithSameArguments:
+			gen.Add(" The `%s` method has a base case, but it calls itself with the same `%s` every time so the base case is never reached.", ctx.methodName, ctx.parameter)
+		case recursionAwayFromBaseCase:
+			gen.Add(" The `%s` method has a base case, but the recursive call `%s` moves `%s` away from it so the base case is never reached.", ctx.methodName, ctx.call.Text(), ctx.parameter)
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(stackOverflowErrorCtx)
+		if ctx.method.IsNull() {
+			return
+		}
+
+		doc := cd.MainError.Document
+		direction := "decrease"
+		if !ctx.decreasing {
+			direction = "increase"
+		}
+
+		recursiveCall := "itself"
+		if len(ctx.cycle) > 1 {
+			recursiveCall = fmt.Sprintf("`%s`", ctx.calleeName)
+		}
+
+		switch ctx.kind {
+		case recursionWithoutBaseCase:
+			gen.Add("Add a base case", func(s *lib.BugFixSuggestion) {
+				parameters := ctx.method.ChildByFieldName("parameters")
+				body := ctx.method.ChildByFieldName("body")
+				if parameters.NamedChildCount() == 0 || body.NamedChildCount() == 0 {
+					s.AddStep("Add a condition to the `%s` method that returns without calling `%s` again.", ctx.methodName, ctx.calleeName)
+					return
+				}
+
+				parameter := parameters.NamedChild(0)
+				firstStatement := body.FirstNamedChild()
+				spaces := getSpaceFromBeginning(doc, firstStatement.StartPosition().Line, firstStatement.StartPosition().Column)
+
+				returnStatement := "return;"
+				if returnType := ctx.method.ChildByFieldName("type"); returnType.Text() != "void" {
+					returnTypeSym := cd.Analyzer.AnalyzeNode(context.Background(), returnType)
+					returnStatement = fmt.Sprintf("return %s;", getDefaultValueForType(returnTypeSym))
+				}
+
+				s.AddStep(
+					"Add a condition at the start of `%s` that returns without calling %s once `%s` reaches its simplest value.",
+					ctx.methodName,
+					recursiveCall,
+					parameter.ChildByFieldName("name").Text(),
+				).AddFix(lib.FixSuggestion{
+					NewText: fmt.Sprintf("if (%s) {\n%s%s%s\n%s}\n%s",
+						baseCaseCondition(cd, parameter), spaces, getIndent(spaces, 1), returnStatement, spaces, spaces),
+					StartPosition: firstStatement.StartPosition(),
+					EndPosition:   firstStatement.StartPosition(),
+					Description:   "Replace the condition and the returned value with the expected result for the simplest input.",
+				})
+			})
+		case recursionWithSameArguments:
+			gen.Add(fmt.Sprintf("Change the value of %s in the recursive call", ctx.parameter), func(s *lib.BugFixSuggestion) {
+				newArgument := fmt.Sprintf("%s - 1", ctx.parameter)
+				if !ctx.decreasing {
+					newArgument = fmt.Sprintf("%s + 1", ctx.parameter)
+				}
+
+				s.AddStep("Pass a value that gets closer to the base case each time `%s` calls itself.", ctx.methodName).
+					AddFix(lib.FixSuggestion{
+						NewText:       newArgument,
+						StartPosition: ctx.argument.StartPosition(),
+						EndPosition:   ctx.argument.EndPosition(),
+						Description:   fmt.Sprintf("This makes `%s` %s on every call until the base case is reached.", ctx.parameter, direction),
+					})
+			})
+		case recursionAwayFromBaseCase:
+			gen.Add("Make progress toward the base case", func(s *lib.BugFixSuggestion) {
+				operatorNode := ctx.argument.ChildByFieldName("operator")
+				newOperator := "-"
+				if !ctx.decreasing {
+					newOperator = "+"
+				}
+
+				s.AddStep("Change the recursive call so that `%s` gets closer to the base case instead.", ctx.parameter).
+					AddFix(lib.FixSuggestion{
+						NewText:       newOperator,
+						StartPosition: operatorNode.StartPosition(),
+						EndPosition:   operatorNode.EndPosition(),
+					})
+			})
+		default:
+			gen.Add("Check the base case", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Make sure that the arguments passed when `%s` calls %s eventually satisfy its base case.", ctx.methodName, recursiveCall)
+			})
+		}
+	},
+}
+
+// frameMethodName returns the name of the method of the frame without its class (eg. `factorial`)
+func frameMethodName(frame lib.StackTraceEntry) string {
+	return frame.SymbolName[strings.LastIndex(frame.SymbolName, ".")+1:]
+}
+
+// methodOfFrame returns the declaration of the method which contains the line of the frame
+func methodOfFrame(rootNode lib.SyntaxNode, frame lib.StackTraceEntry) lib.SyntaxNode {
+	for q := rootNode.Query(`(method_declaration name: (identifier) @name (#eq? @name "%s")) @method`, frameMethodName(frame)); q.Next(); {
+		if q.CurrentTagName() != "method" {
+			continue
+		}
+
+		node := q.CurrentNode()
+		if frame.StartPos.Line >= node.StartPosition().Line+1 && frame.StartPos.Line <= node.EndPosition().Line+1 {
+			return node
+		}
+	}
+	return lib.SyntaxNode{}
+}
+
+// findBaseCase returns the if statement of the method which returns without
+// calling any of the given methods
+func findBaseCase(method lib.SyntaxNode, callees []string) lib.SyntaxNode {
+	if method.IsNull() {
+		return lib.SyntaxNode{}
+	}
+
+	for q := method.Query(`(if_statement condition: (_) consequence: (_) @consequence) @if`); q.Next(); {
+		if q.CurrentTagName() != "consequence" {
+			continue
+		}
+
+		consequence := q.CurrentNode()
+		for rq := consequence.Query(`(return_statement) @return`); rq.Next(); {
+			recurses := false
+			for _, callee := range callees {
+				if strings.Contains(rq.CurrentNode().Text(), callee+"(") {
+					recurses = true
+					break
+				}
+			}
+
+			if !recurses {
+				return consequence.Parent()
+			}
+		}
+	}
+	return lib.SyntaxNode{}
+}
+
+// callChain describes how the methods call each other
+// (eg. "`a` calls `b`, which calls `a` again")
+func callChain(methods []string) string {
+	chain := fmt.Sprintf("`%s` calls `%s`", methods[0], methods[1])
+	for _, method := range methods[2:] {
+		chain += fmt.Sprintf(", which calls `%s`", method)
+	}
+	return chain + fmt.Sprintf(", which calls `%s` again", methods[0])
+}
+
+func baseCaseCondition(cd *lib.ContextData, parameter lib.SyntaxNode) string {
+	name := parameter.ChildByFieldName("name").Text()
+	switch cd.Analyzer.AnalyzeNode(context.Background(), parameter.ChildByFieldName("type")) {
+	case java.BuiltinTypes.Integral.IntSymbol,
+		java.BuiltinTypes.Integral.LongSymbol,
+		java.BuiltinTypes.Integral.ShortSymbol,
+		java.BuiltinTypes.FloatingPoint.DoubleSymbol:
+		return fmt.Sprintf("%s <= 0", name)
+	case java.BuiltinTypes.StringSymbol:
+		return fmt.Sprintf("%s.isEmpty()", name)
+	default:
+		return fmt.Sprintf("%s == null", name)
+	}
+}
@@ -0,0 +1,72 @@
+package java
+
+import (
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type superNotFirstStatementErrorCtx struct {
+	call           lib.SyntaxNode
+	firstStatement lib.SyntaxNode
+}
+
+var SuperNotFirstStatementError = lib.ErrorTemplate{
+	Name:              "SuperNotFirstStatementError",
+	Pattern:           comptimeErrorPattern(`call to (?P<call>super|this) must be first statement in constructor`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		sCtx := superNotFirstStatementErrorCtx{}
+		// the parser only accepts the call as the first statement of the constructor,
+		// so the misplaced call is parsed as an error followed by its arguments
+		sCtx.call = nodeOnErrorLine(m, "call", `(constructor_body [(explicit_constructor_invocation) (ERROR)] @call)`)
+		if sCtx.call.IsNull() {
+			m.Context = sCtx
+			return
+		}
+
+		m.Nearest = sCtx.call
+		sCtx.firstStatement = sCtx.call.Parent().FirstNamedChild()
+
+		m.Context = sCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		call := cd.Variables["call"]
+		gen.Add("This error occurs when `%s(...)` is called after other statements in a constructor. ", call)
+
+		if call == "super" {
+			gen.Add("The superclass must be initialized first before the constructor can do anything else, so `super(...)` has to be the first statement.")
+		} else {
+			gen.Add("A constructor that calls another constructor must do so before anything else, so `this(...)` has to be the first statement.")
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(superNotFirstStatementErrorCtx)
+		if ctx.call.IsNull() || ctx.firstStatement.IsNull() {
+			return
+		}
+
+		call := cd.Variables["call"]
+		gen.Add("Move the call to the start of the constructor", func(s *lib.BugFixSuggestion) {
+			doc := cd.MainError.Document
+			callLine := ctx.call.StartPosition().Line
+			firstPos := ctx.firstStatement.StartPosition()
+			spaces := getSpaceFromBeginning(doc, firstPos.Line, firstPos.Column)
+
+			callText := strings.TrimSpace(doc.LineAt(callLine))
+
+			s.AddStep("Call `%s` at the start of the constructor before the other statements.", call).
+				AddFix(lib.FixSuggestion{
+					NewText:       callText + "\n" + spaces,
+					StartPosition: firstPos,
+					EndPosition:   firstPos,
+					Description:   "Make sure that the arguments do not depend on the statements which are now after the call.",
+				}).
+				AddFix(lib.FixSuggestion{
+					NewText:       "",
+					StartPosition: lib.Position{Line: callLine},
+					EndPosition:   lib.Position{Line: callLine, Column: len(doc.LineAt(callLine))},
+				})
+		})
+	},
+}
@@ -0,0 +1,145 @@
+package java
+
+import (
+	"fmt"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type switchNotExhaustiveErrorCtx struct {
+	switchNode lib.SyntaxNode
+	// constants of the enum which are not handled by any case
+	missingConstants []string
+	// the value given to the missing cases based on the type of the switch expression
+	defaultValue string
+}
+
+var SwitchNotExhaustiveError = lib.ErrorTemplate{
+	Name:              "SwitchNotExhaustiveError",
+	Pattern:           comptimeErrorPattern(`the switch (?P<kind>statement|expression) does not cover all possible input values`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		sCtx := switchNotExhaustiveErrorCtx{}
+		sCtx.switchNode = nodeOnErrorLine(m, "switch", `(switch_expression) @switch`)
+		if sCtx.switchNode.IsNull() {
+			m.Context = sCtx
+			return
+		}
+
+		// highlight only the keyword since the switch spans multiple lines
+		m.Nearest = sCtx.switchNode.Child(0)
+		selector := sCtx.switchNode.ChildByFieldName("condition").NamedChild(0)
+		if selector.Type() == "identifier" {
+			declaration := findVariableDeclaration(cd, m.Document, selector, selector.Text())
+			if !declaration.IsNull() {
+				sCtx.missingConstants = missingEnumConstants(m.Document, declaration.ChildByFieldName("type").Text(), sCtx.switchNode)
+			}
+		}
+
+		// the value of the switch expression is assigned to a variable
+		if declarator := sCtx.switchNode.Parent(); declarator.Type() == "variable_declarator" {
+			typeNode := declarator.Parent().ChildByFieldName("type")
+			sCtx.defaultValue = getDefaultValueForType(cd.FindSymbol(typeNode.Text(), -1))
+		}
+
+		m.Context = sCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(switchNotExhaustiveErrorCtx)
+		gen.Add("This error occurs when a `switch` %s does not have a case for every possible value that it checks.", cd.Variables["kind"])
+		if ctx.switchNode.IsNull() {
+			return
+		}
+
+		header := switchHeader(ctx.switchNode)
+		if len(ctx.missingConstants) != 0 {
+			gen.Add(" `%s` has no case for %s.", header, joinConstants(ctx.missingConstants))
+			return
+		}
+
+		gen.Add(" `%s` needs a `default` case for the values that are not handled by the other cases.", header)
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(switchNotExhaustiveErrorCtx)
+		if ctx.switchNode.IsNull() {
+			return
+		}
+
+		doc := cd.MainError.Document
+		switchBlock := ctx.switchNode.ChildByFieldName("body")
+		lastMember := switchBlock.LastNamedChild()
+		if lastMember.IsNull() {
+			return
+		}
+
+		memberPos := lastMember.StartPosition()
+		spaces := getSpaceFromBeginning(doc, memberPos.Line, memberPos.Column)
+		usesRules := lastMember.Type() == "switch_rule"
+		selector := ctx.switchNode.ChildByFieldName("condition").NamedChild(0).Text()
+
+		if len(ctx.missingConstants) != 0 && usesRules && len(ctx.defaultValue) != 0 {
+			gen.Add("Add the missing cases", func(s *lib.BugFixSuggestion) {
+				cases := make([]string, len(ctx.missingConstants))
+				for i, constant := range ctx.missingConstants {
+					cases[i] = fmt.Sprintf("\n%scase %s -> %s;", spaces, constant, ctx.defaultValue)
+				}
+
+				s.AddStep("Add a case for %s.", joinConstants(ctx.missingConstants)).
+					AddFix(lib.FixSuggestion{
+						NewText:       strings.Join(cases, ""),
+						StartPosition: lastMember.EndPosition(),
+						EndPosition:   lastMember.EndPosition(),
+						Description:   fmt.Sprintf("Replace `%s` with the actual value of each case.", ctx.defaultValue),
+					})
+			})
+		}
+
+		gen.Add("Add a default case", func(s *lib.BugFixSuggestion) {
+			throwStatement := fmt.Sprintf("throw new IllegalStateException(\"Unexpected value: \" + %s);", selector)
+			defaultCase := fmt.Sprintf("\n%sdefault -> %s", spaces, throwStatement)
+			if !usesRules {
+				defaultCase = fmt.Sprintf("\n%sdefault:\n%s%s%s", spaces, spaces, getIndent(spaces, 1), throwStatement)
+			}
+
+			s.AddStep("Add a `default` case at the end of `%s` for the values that are not handled by the other cases.", switchHeader(ctx.switchNode)).
+				AddFix(lib.FixSuggestion{
+					NewText:       defaultCase,
+					StartPosition: lastMember.EndPosition(),
+					EndPosition:   lastMember.EndPosition(),
+					Description:   "You may also return a value instead of throwing an exception if there is a sensible value for the other cases.",
+				})
+		})
+	},
+}
+
+// missingEnumConstants returns the constants of the enum declared in the document
+// which are not handled by the cases of the switch
+func missingEnumConstants(doc *lib.Document, enumName string, switchNode lib.SyntaxNode) []string {
+	handled := map[string]bool{}
+	for q := switchNode.ChildByFieldName("body").Query(`(switch_label) @label`); q.Next(); {
+		for _, value := range labelValues(q.CurrentNode()) {
+			// qualified constants (eg. `Size.SMALL`) are also allowed in newer versions
+			if value.Type() == "field_access" {
+				value = value.ChildByFieldName("field")
+			}
+			handled[value.Text()] = true
+		}
+	}
+
+	missing := []string{}
+	for q := doc.RootNode().Query(`(enum_declaration name: (identifier) @name (#eq? @name "%s") body: (enum_body (enum_constant name: (identifier) @constant)))`, enumName); q.Next(); {
+		if constant := q.CurrentNode(); q.CurrentTagName() == "constant" && !handled[constant.Text()] {
+			missing = append(missing, constant.Text())
+		}
+	}
+	return missing
+}
+
+func joinConstants(constants []string) string {
+	quoted := make([]string, len(constants))
+	for i, constant := range constants {
+		quoted[i] = fmt.Sprintf("`%s`", constant)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -18,6 +18,9 @@ type symbolNotFoundErrorCtx struct {
 	locationNode     lib.SyntaxNode
 	rootNode         lib.SyntaxNode
 	parentNode       lib.SyntaxNode
+	// loop ended by a semicolon right after its header which declares the variable
+	emptyLoop          lib.SyntaxNode
+	emptyLoopSemicolon lib.SyntaxNode
 }
 
 var SymbolNotFoundError = lib.ErrorTemplate{
@@ -49,6 +52,13 @@ var SymbolNotFoundError = lib.ErrorTemplate{
 			break
 		}
 
+		if errorCtx.symbolType == "variable" {
+			if loop, semicolon := precedingEmptyBody(m.Nearest); loopDeclares(loop, symbolName) {
+				errorCtx.emptyLoop = loop
+				errorCtx.emptyLoopSemicolon = semicolon
+			}
+		}
+
 		// locate the location node
 		if len(errorCtx.locationClass) > 0 {
 			rootNode := m.Nearest.Doc.RootNode()
@@ -126,6 +136,9 @@ var SymbolNotFoundError = lib.ErrorTemplate{
 		switch ctx.symbolType {
 		case "variable":
 			gen.Add(`The error indicates that the compiler cannot find variable "%s"`, ctx.symbolName)
+			if !ctx.emptyLoop.IsNull() {
+				gen.Add(". The semicolon after `%s` ends the loop, so `%s` is only available in the loop and not in the code after it.", statementHeader(ctx.emptyLoop), ctx.symbolName)
+			}
 		case "method":
 			gen.Add("The error indicates that the compiler cannot find the method `%s` in the `%s` class.", ctx.symbolName, ctx.locationClass)
 		case "class":
@@ -136,6 +149,18 @@ var SymbolNotFoundError = lib.ErrorTemplate{
 		ctx := cd.MainError.Context.(symbolNotFoundErrorCtx)
 		switch ctx.symbolType {
 		case "variable":
+			if !ctx.emptyLoop.IsNull() {
+				gen.Add("Remove the semicolon after the loop", func(s *lib.BugFixSuggestion) {
+					s.AddStep("Remove the `;` after `%s` so that the code after it becomes the body of the loop.", statementHeader(ctx.emptyLoop)).
+						AddFix(lib.FixSuggestion{
+							NewText:       "",
+							StartPosition: ctx.emptyLoopSemicolon.StartPosition(),
+							EndPosition:   ctx.emptyLoopSemicolon.EndPosition(),
+						})
+				})
+				return
+			}
+
 			affectedStatementPosition := ctx.rootNode.StartPosition()
 			space := getSpaceFromBeginning(cd.MainError.Document, affectedStatementPosition.Line, affectedStatementPosition.Column)
 
@@ -196,6 +221,25 @@ var SymbolNotFoundError = lib.ErrorTemplate{
 	},
 }
 
+// loopDeclares checks if the variable is declared in the header of the loop
+func loopDeclares(loop lib.SyntaxNode, name string) bool {
+	if loop.IsNull() {
+		return false
+	}
+
+	switch loop.Type() {
+	case "for_statement":
+		if init := loop.ChildByFieldName("init"); !init.IsNull() {
+			for q := init.Query(`(variable_declarator name: (identifier) @name (#eq? @name "%s"))`, name); q.Next(); {
+				return true
+			}
+		}
+	case "enhanced_for_statement":
+		return loop.ChildByFieldName("name").Text() == name
+	}
+	return false
+}
+
 func parseMethodSignature(symbolName string) (methodName string, parameterTypes []string) {
 	openingPar := strings.Index(symbolName, "(")
 	closingPar := strings.Index(symbolName, ")")
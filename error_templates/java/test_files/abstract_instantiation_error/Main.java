@@ -0,0 +1,30 @@
+public class Main {
+    public static void main(String[] args) {
+        Animal pet = new Animal("Rex");
+        System.out.println(pet.getName() + " says " + pet.speak());
+    }
+}
+
+abstract class Animal {
+    private String name;
+
+    Animal(String name) {
+        this.name = name;
+    }
+
+    public String getName() {
+        return name;
+    }
+
+    public abstract String speak();
+}
+
+class Dog extends Animal {
+    Dog(String name) {
+        super(name);
+    }
+
+    public String speak() {
+        return "Woof";
+    }
+}
@@ -0,0 +1,12 @@
+public class Main {
+    interface Counter {
+        int next();
+
+        void reset();
+    }
+
+    public static void main(String[] args) {
+        Counter counter = new Counter();
+        System.out.println(counter.next());
+    }
+}
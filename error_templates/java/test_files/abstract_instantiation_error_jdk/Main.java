@@ -0,0 +1,9 @@
+import java.util.List;
+
+public class Main {
+    public static void main(String[] args) {
+        List<Integer> nums = new List<>();
+        nums.add(1);
+        System.out.println(nums);
+    }
+}
@@ -0,0 +1,7 @@
+public class Main {
+    public static void main(String[] args) {
+        int population = 2000000000;
+        int total = Math.multiplyExact(population, 3);
+        System.out.println(total);
+    }
+}
@@ -0,0 +1,7 @@
+public class Main {
+    public static void main(String[] args) {
+        int side = 65536;
+        int area = side * side;
+        System.out.println(100 / area);
+    }
+}
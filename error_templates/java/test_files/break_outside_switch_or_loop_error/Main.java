@@ -0,0 +1,12 @@
+public class Main {
+    public static void main(String[] args) {
+        int balance = 100;
+        int withdrawal = 150;
+        if (withdrawal > balance) {
+            System.out.println("Insufficient funds.");
+            break;
+        }
+        balance -= withdrawal;
+        System.out.println("New balance: " + balance);
+    }
+}
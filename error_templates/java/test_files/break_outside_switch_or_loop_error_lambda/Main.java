@@ -0,0 +1,13 @@
+import java.util.List;
+
+public class Main {
+    public static void main(String[] args) {
+        List<Integer> numbers = List.of(4, 8, -1, 15);
+        numbers.forEach(number -> {
+            if (number < 0) {
+                break;
+            }
+            System.out.println(number);
+        });
+    }
+}
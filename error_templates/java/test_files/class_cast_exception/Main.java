@@ -0,0 +1,11 @@
+import java.util.ArrayList;
+import java.util.List;
+
+public class Main {
+    public static void main(String[] args) {
+        List<Object> items = new ArrayList<>();
+        items.add(42);
+        String text = (String) items.get(0);
+        System.out.println(text.toUpperCase());
+    }
+}
@@ -0,0 +1,21 @@
+public class Main {
+    static class Animal {
+        void speak() {
+            System.out.println("...");
+        }
+    }
+
+    static class Dog extends Animal {}
+
+    static class Cat extends Animal {
+        void purr() {
+            System.out.println("Purr");
+        }
+    }
+
+    public static void main(String[] args) {
+        Animal pet = new Dog();
+        Cat cat = (Cat) pet;
+        cat.purr();
+    }
+}
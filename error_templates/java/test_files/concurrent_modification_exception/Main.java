@@ -0,0 +1,14 @@
+import java.util.ArrayList;
+import java.util.List;
+
+public class Main {
+    public static void main(String[] args) {
+        List<String> names = new ArrayList<>(List.of("Ana", "Ben", "Cole", "Dina"));
+        for (String name : names) {
+            if (name.startsWith("B")) {
+                names.remove(name);
+            }
+        }
+        System.out.println(names);
+    }
+}
@@ -0,0 +1,15 @@
+import java.util.ArrayList;
+import java.util.List;
+
+public class Main {
+    public static void main(String[] args) {
+        List<Integer> scores = new ArrayList<>(List.of(80, 45, 90, 70));
+        for (Integer score : scores) {
+            System.out.println("Checking " + score);
+            if (score < 50) {
+                scores.remove(score);
+            }
+        }
+        System.out.println(scores);
+    }
+}
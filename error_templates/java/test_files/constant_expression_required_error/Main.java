@@ -0,0 +1,14 @@
+public class Main {
+    public static void main(String[] args) {
+        int choice = 3;
+        int exitOption = 3;
+        switch (choice) {
+            case 1:
+                System.out.println("Starting the game");
+                break;
+            case exitOption:
+                System.out.println("Goodbye");
+                break;
+        }
+    }
+}
@@ -0,0 +1,20 @@
+public class Main {
+    public static void main(String[] args) {
+        Person person = new Person("Ana");
+        System.out.println(person.describe());
+    }
+}
+
+class Person {
+    private String name;
+    private int age;
+
+    Person(String name, int age) {
+        this.name = name;
+        this.age = age;
+    }
+
+    String describe() {
+        return name + " is " + age + " years old";
+    }
+}
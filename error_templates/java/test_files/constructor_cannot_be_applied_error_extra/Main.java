@@ -0,0 +1,23 @@
+public class Main {
+    public static void main(String[] args) {
+        Counter counter = new Counter(10, 2);
+        counter.increment();
+        System.out.println(counter.getValue());
+    }
+}
+
+class Counter {
+    private int value;
+
+    Counter(int start) {
+        this.value = start;
+    }
+
+    void increment() {
+        value++;
+    }
+
+    int getValue() {
+        return value;
+    }
+}
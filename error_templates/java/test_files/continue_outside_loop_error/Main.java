@@ -0,0 +1,13 @@
+import java.util.List;
+
+public class Main {
+    public static void main(String[] args) {
+        List<String> names = List.of("Ana", "", "Ben");
+        names.forEach(name -> {
+            if (name.isEmpty()) {
+                continue;
+            }
+            System.out.println("Hello, " + name);
+        });
+    }
+}
@@ -0,0 +1,16 @@
+public class Main {
+    public static void main(String[] args) {
+        int day = 6;
+        switch (day) {
+            case 1:
+                System.out.println("Monday");
+                break;
+            case 6:
+                System.out.println("Saturday");
+                break;
+            case 6:
+                System.out.println("Sunday");
+                break;
+        }
+    }
+}
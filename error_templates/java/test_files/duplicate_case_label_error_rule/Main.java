@@ -0,0 +1,12 @@
+public class Main {
+    public static void main(String[] args) {
+        String command = "stop";
+        String message = switch (command) {
+            case "start" -> "Starting";
+            case "stop", "halt" -> "Stopping";
+            case "pause", "stop" -> "Pausing";
+            default -> "Unknown command";
+        };
+        System.out.println(message);
+    }
+}
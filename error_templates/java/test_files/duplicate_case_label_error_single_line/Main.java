@@ -0,0 +1,10 @@
+public class Main {
+    public static void main(String[] args) {
+        String key = "a";
+        switch (key) {
+            case "a": System.out.println("first"); break;
+            case "b": System.out.println("second"); break;
+            case "a": break;
+        }
+    }
+}
@@ -0,0 +1,10 @@
+public class Main {
+    public static void main(String[] args) {
+        int score = 85;
+        if (score >= 75); {
+            System.out.println("Passed");
+        } else {
+            System.out.println("Failed");
+        }
+    }
+}
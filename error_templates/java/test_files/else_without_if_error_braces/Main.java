@@ -0,0 +1,10 @@
+public class Main {
+    public static void main(String[] args) {
+        int age = 15;
+        if (age < 18)
+            System.out.println("You are a minor.");
+            System.out.println("Please ask a guardian.");
+        else
+            System.out.println("Welcome!");
+    }
+}
@@ -0,0 +1,12 @@
+public class Main {
+    public static void main(String[] args) {
+        boolean isRaining = true;
+        if (isRaining) {
+            System.out.println("Take an umbrella.");
+        }
+            System.out.println("Wear boots.");
+        else {
+            System.out.println("Enjoy the sun.");
+        }
+    }
+}
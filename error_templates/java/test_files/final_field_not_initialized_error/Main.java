@@ -0,0 +1,16 @@
+public class Main {
+    public static void main(String[] args) {
+        Account account = new Account();
+        account.printBalance();
+    }
+}
+
+class Account {
+    private final double balance;
+    private String owner;
+
+    public void printBalance() {
+        System.out.print("Balance: ");
+        System.out.println(balance);
+    }
+}
@@ -0,0 +1,11 @@
+public class Main {
+    public static void main(String[] args) {
+        final int maxAttempts = 3;
+        int attempts = 0;
+        while (attempts < maxAttempts) {
+            attempts++;
+        }
+        maxAttempts = 5;
+        System.out.println(maxAttempts);
+    }
+}
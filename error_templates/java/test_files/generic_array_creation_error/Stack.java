@@ -0,0 +1,12 @@
+public class Stack<T> {
+    private T[] items;
+    private int size;
+
+    public Stack(int capacity) {
+        items = new T[capacity];
+    }
+
+    public void push(T item) {
+        items[size++] = item;
+    }
+}
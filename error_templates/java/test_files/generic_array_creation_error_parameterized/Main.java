@@ -0,0 +1,9 @@
+import java.util.ArrayList;
+import java.util.List;
+
+public class Main {
+    public static void main(String[] args) {
+        List<String>[] groups = new ArrayList<String>[3];
+        System.out.println(groups.length);
+    }
+}
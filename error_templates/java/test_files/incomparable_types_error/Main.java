@@ -0,0 +1,9 @@
+public class Main {
+    public static void main(String[] args) {
+        String input = "42";
+        Integer expected = 42;
+        if (input == expected) {
+            System.out.println("Match!");
+        }
+    }
+}
@@ -0,0 +1,7 @@
+public class Main {
+    public static void main(String[] args) {
+        String text = "123";
+        Integer number = (Integer) text;
+        System.out.println(number + 1);
+    }
+}
@@ -0,0 +1,10 @@
+import java.util.ArrayList;
+
+public class Main {
+    public static void main(String[] args) {
+        ArrayList names = new ArrayList();
+        names.add("Alice");
+        String first = names.get(0);
+        System.out.println(first);
+    }
+}
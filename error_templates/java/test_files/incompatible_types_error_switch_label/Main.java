@@ -0,0 +1,13 @@
+public class Main {
+    public static void main(String[] args) {
+        int month = 2;
+        switch (month) {
+            case "1":
+                System.out.println("January");
+                break;
+            case 2:
+                System.out.println("February");
+                break;
+        }
+    }
+}
@@ -0,0 +1,11 @@
+import java.util.ArrayList;
+import java.util.List;
+
+public class Main {
+    public static void main(String[] args) {
+        List<String> tasks = new ArrayList<>(List.of("Write", "Review"));
+        int last = tasks.size();
+        tasks.remove(last);
+        System.out.println(tasks);
+    }
+}
@@ -0,0 +1,6 @@
+public class Main {
+    public static void main(String[] args) {
+        int worldPopulation = 8000000000;
+        System.out.println(worldPopulation);
+    }
+}
@@ -0,0 +1,6 @@
+public class Main {
+    public static void main(String[] args) {
+        long factorial = 51090942171709440000L;
+        System.out.println(factorial);
+    }
+}
@@ -0,0 +1,6 @@
+public class Main {
+    public static void main(String[] args) {
+        long distance = 149_600_000_000;
+        System.out.println(distance);
+    }
+}
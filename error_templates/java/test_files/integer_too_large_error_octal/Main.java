@@ -0,0 +1,6 @@
+public class Main {
+    public static void main(String[] args) {
+        int month = 09;
+        System.out.println(month);
+    }
+}
@@ -0,0 +1,6 @@
+public class Main {
+    public static void main(String[] args) {
+        double distance = 1.5e;
+        System.out.println(distance);
+    }
+}
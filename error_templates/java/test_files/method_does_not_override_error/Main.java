@@ -0,0 +1,19 @@
+public class Main {
+    public static void main(String[] args) {
+        Animal animal = new Cat();
+        System.out.println(animal.makeSound());
+    }
+}
+
+class Animal {
+    public String makeSound() {
+        return "...";
+    }
+}
+
+class Cat extends Animal {
+    @Override
+    public String makeSond() {
+        return "Meow";
+    }
+}
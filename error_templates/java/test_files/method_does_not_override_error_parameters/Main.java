@@ -0,0 +1,20 @@
+public class Main {
+    static class Point {
+        private int x;
+        private int y;
+
+        Point(int x, int y) {
+            this.x = x;
+            this.y = y;
+        }
+
+        @Override
+        public boolean equals(Point other) {
+            return x == other.x && y == other.y;
+        }
+    }
+
+    public static void main(String[] args) {
+        System.out.println(new Point(1, 2).equals(new Point(1, 2)));
+    }
+}
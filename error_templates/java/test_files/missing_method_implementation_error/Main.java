@@ -0,0 +1,20 @@
+public class Main {
+    public static void main(String[] args) {
+        Shape shape = new Circle(2.0);
+        System.out.println(shape.describe("Area: ") + shape.area());
+    }
+}
+
+abstract class Shape {
+    public abstract double area();
+
+    public abstract String describe(String prefix);
+}
+
+class Circle extends Shape {
+    private double radius;
+
+    Circle(double radius) {
+        this.radius = radius;
+    }
+}
@@ -0,0 +1,18 @@
+public class Main {
+    interface Greeter {
+        String greet(String name);
+
+        boolean isFormal();
+    }
+
+    static class EnglishGreeter implements Greeter {
+        public String greet(String name) {
+            return "Hello, " + name;
+        }
+    }
+
+    public static void main(String[] args) {
+        Greeter greeter = new EnglishGreeter();
+        System.out.println(greeter.greet("Ada"));
+    }
+}
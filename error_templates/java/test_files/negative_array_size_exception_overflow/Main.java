@@ -0,0 +1,8 @@
+public class Main {
+    public static void main(String[] args) {
+        int width = 50000;
+        int height = 50000;
+        int[] pixels = new int[width * height];
+        System.out.println(pixels.length);
+    }
+}
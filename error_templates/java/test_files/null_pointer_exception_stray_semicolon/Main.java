@@ -0,0 +1,7 @@
+public class Main {
+    public static void main(String[] args) {
+        String name = null;
+        if (name != null);
+            System.out.println(name.length());
+    }
+}
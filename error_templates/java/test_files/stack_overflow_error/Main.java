@@ -0,0 +1,9 @@
+public class Main {
+    static int factorial(int n) {
+        return n * factorial(n - 1);
+    }
+
+    public static void main(String[] args) {
+        System.out.println(factorial(5));
+    }
+}
@@ -0,0 +1,14 @@
+public class Main {
+    static void countdown(int n) {
+        if (n == 0) {
+            System.out.println("Liftoff!");
+            return;
+        }
+        System.out.println(n);
+        countdown(n + 1);
+    }
+
+    public static void main(String[] args) {
+        countdown(3);
+    }
+}
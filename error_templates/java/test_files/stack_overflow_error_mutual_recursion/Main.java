@@ -0,0 +1,13 @@
+public class Main {
+    static int depth(int n) {
+        return helper(n + 1);
+    }
+
+    static int helper(int n) {
+        return depth(n * 2);
+    }
+
+    public static void main(String[] args) {
+        System.out.println(depth(1));
+    }
+}
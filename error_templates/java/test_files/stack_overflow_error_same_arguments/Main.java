@@ -0,0 +1,12 @@
+public class Main {
+    static int sum(int n) {
+        if (n == 0) {
+            return 0;
+        }
+        return n + sum(n);
+    }
+
+    public static void main(String[] args) {
+        System.out.println(sum(10));
+    }
+}
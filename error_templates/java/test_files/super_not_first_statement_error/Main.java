@@ -0,0 +1,21 @@
+public class Main {
+    public static void main(String[] args) {
+        Dog dog = new Dog("Rex");
+        System.out.println(dog.name);
+    }
+}
+
+class Animal {
+    String name;
+
+    Animal(String name) {
+        this.name = name;
+    }
+}
+
+class Dog extends Animal {
+    Dog(String name) {
+        System.out.println("Creating a dog");
+        super(name);
+    }
+}
@@ -0,0 +1,12 @@
+public class Main {
+    enum Size { SMALL, MEDIUM, LARGE }
+
+    public static void main(String[] args) {
+        Size size = Size.MEDIUM;
+        int price = switch (size) {
+            case SMALL -> 5;
+            case MEDIUM -> 7;
+        };
+        System.out.println(price);
+    }
+}
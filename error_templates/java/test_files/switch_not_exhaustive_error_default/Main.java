@@ -0,0 +1,11 @@
+public class Main {
+    public static void main(String[] args) {
+        int level = 2;
+        String label = switch (level) {
+            case 1 -> "Beginner";
+            case 2 -> "Intermediate";
+            case 3 -> "Advanced";
+        };
+        System.out.println(label);
+    }
+}
@@ -0,0 +1,9 @@
+public class Main {
+    public static void main(String[] args) {
+        int sum = 0;
+        for (int i = 1; i <= 5; i++); {
+            sum += i;
+        }
+        System.out.println(sum);
+    }
+}
@@ -0,0 +1,10 @@
+import java.util.ArrayList;
+import java.util.List;
+
+public class Main {
+    public static void main(String[] args) {
+        List<int> scores = new ArrayList<>();
+        scores.add(90);
+        System.out.println(scores.get(0));
+    }
+}
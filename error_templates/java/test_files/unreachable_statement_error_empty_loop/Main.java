@@ -0,0 +1,9 @@
+public class Main {
+    public static void main(String[] args) {
+        int ticks = 0;
+        while (true); {
+            ticks++;
+            System.out.println("Tick " + ticks);
+        }
+    }
+}
@@ -0,0 +1,7 @@
+public class Main {
+    public static void main(String[] args) {
+        System.out.println("Waiting...");
+        Thread.sleep(1000);
+        System.out.println("Done!");
+    }
+}
@@ -0,0 +1,15 @@
+import java.io.BufferedReader;
+import java.io.FileReader;
+
+public class Main {
+    private BufferedReader reader;
+
+    public Main(String path) {
+        reader = new BufferedReader(new FileReader(path));
+    }
+
+    public static void main(String[] args) {
+        Main app = new Main("input.txt");
+        System.out.println("Reader ready");
+    }
+}
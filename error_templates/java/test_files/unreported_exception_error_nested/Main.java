@@ -0,0 +1,20 @@
+import java.io.File;
+import java.util.Scanner;
+
+public class Main {
+    static int countLines(String path) {
+        int count = 0;
+        if (path.endsWith(".txt")) {
+            Scanner scanner = new Scanner(new File(path));
+            while (scanner.hasNextLine()) {
+                scanner.nextLine();
+                count++;
+            }
+        }
+        return count;
+    }
+
+    public static void main(String[] args) {
+        System.out.println(countLines("notes.txt"));
+    }
+}
@@ -0,0 +1,9 @@
+import java.util.List;
+
+public class Main {
+    public static void main(String[] args) {
+        List<String> colors = List.of("red", "green");
+        colors.add("blue");
+        System.out.println(colors);
+    }
+}
@@ -0,0 +1,10 @@
+import java.util.Arrays;
+import java.util.List;
+
+public class Main {
+    public static void main(String[] args) {
+        List<Integer> numbers = Arrays.asList(1, 2, 3);
+        numbers.remove(0);
+        System.out.println(numbers);
+    }
+}
@@ -0,0 +1,8 @@
+public class Main {
+    public static void main(String[] args) {
+        final String greeting;
+        greeting = "Hello";
+        greeting = "Hi";
+        System.out.println(greeting);
+    }
+}
@@ -0,0 +1,22 @@
+public class Main {
+    public static void main(String[] args) {
+        Shape shape = new Square(3);
+        System.out.println(shape.area());
+    }
+}
+
+interface Shape {
+    double area();
+}
+
+class Square implements Shape {
+    private double side;
+
+    Square(double side) {
+        this.side = side;
+    }
+
+    double area() {
+        return side * side;
+    }
+}
@@ -0,0 +1,17 @@
+public class Main {
+    static abstract class Employee {
+        protected abstract double salary();
+    }
+
+    static class Intern extends Employee {
+        @Override
+        private double salary() {
+            return 1200.0;
+        }
+    }
+
+    public static void main(String[] args) {
+        Employee intern = new Intern();
+        System.out.println(intern.salary());
+    }
+}
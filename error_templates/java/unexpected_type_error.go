@@ -0,0 +1,89 @@
+package java
+
+import (
+	"context"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/languages/java"
+)
+
+type unexpectedTypeErrorCtx struct {
+	genericType lib.SyntaxNode
+	symbol      java.GenericSymbol
+	// the type arguments which are primitive types
+	primitives []lib.SyntaxNode
+}
+
+var UnexpectedTypeError = lib.ErrorTemplate{
+	Name:              "UnexpectedTypeError",
+	Pattern:           comptimeErrorPattern(`unexpected type`, `required:\s+(?P<required>\S+)\s+found:\s+(?P<found>\S+)`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		uCtx := unexpectedTypeErrorCtx{}
+		if cd.Variables["required"] != "reference" {
+			m.Context = uCtx
+			return
+		}
+
+		uCtx.genericType = nodeOnErrorLine(m, "type", `(generic_type (type_arguments [(integral_type) (floating_point_type) (boolean_type)])) @type`)
+		if uCtx.genericType.IsNull() {
+			m.Context = uCtx
+			return
+		}
+
+		m.Nearest = uCtx.genericType
+		if sym, ok := cd.Analyzer.AnalyzeNode(context.Background(), uCtx.genericType).(java.GenericSymbol); ok {
+			uCtx.symbol = sym
+			typeArgs := uCtx.genericType.NamedChild(1)
+			for i, arg := range sym.TypeArguments {
+				if len(boxedType(arg)) != 0 {
+					uCtx.primitives = append(uCtx.primitives, typeArgs.NamedChild(i))
+				}
+			}
+		}
+
+		if len(uCtx.primitives) != 0 {
+			m.Nearest = uCtx.primitives[0]
+		}
+
+		m.Context = uCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(unexpectedTypeErrorCtx)
+		required, found := cd.Variables["required"], cd.Variables["found"]
+		switch {
+		case required == "variable":
+			gen.Add("This error occurs when a value is used where a variable is expected, such as assigning to or incrementing something that is not a variable.")
+		case required == "reference" && len(ctx.primitives) != 0:
+			gen.Add(
+				"This error occurs when a primitive type such as `%s` is used where Java expects a class. Generic classes like `%s` can only hold objects, so their type arguments cannot be primitive types.",
+				found, ctx.symbol.BaseSymbol.Name(),
+			)
+
+			wrapper := boxedType(cd.Analyzer.AnalyzeNode(context.Background(), ctx.primitives[0]))
+			gen.Add(
+				" Each primitive type has a wrapper class that can be used instead, which is `%s` for `%s`. Java automatically converts between `%s` and `%s` values (also known as boxing and unboxing), so the elements can still be used like `%s` values.",
+				wrapper, found, found, wrapper, found,
+			)
+		default:
+			gen.Add("This error occurs when a `%s` is used where a %s is expected.", found, required)
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(unexpectedTypeErrorCtx)
+		if len(ctx.primitives) == 0 {
+			return
+		}
+
+		gen.Add("Use the wrapper class", func(s *lib.BugFixSuggestion) {
+			step := s.AddStep("Replace the primitive types in `%s` with their wrapper classes.", ctx.genericType.Text())
+			for _, primitive := range ctx.primitives {
+				step.AddFix(lib.FixSuggestion{
+					NewText:       boxedType(cd.Analyzer.AnalyzeNode(context.Background(), primitive)),
+					StartPosition: primitive.StartPosition(),
+					EndPosition:   primitive.EndPosition(),
+				})
+			}
+		})
+	},
+}
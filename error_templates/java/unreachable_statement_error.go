@@ -4,17 +4,49 @@ import (
 	lib "github.com/nedpals/errgoengine"
 )
 
+type unreachableStatementErrorCtx struct {
+	// infinite loop ended by a semicolon right after its header (eg. `while (true);`)
+	emptyLoop          lib.SyntaxNode
+	emptyLoopSemicolon lib.SyntaxNode
+}
+
 var UnreachableStatementError = lib.ErrorTemplate{
 	Name:              "UnreachableStatementError",
 	Pattern:           comptimeErrorPattern("unreachable statement"),
 	StackTracePattern: comptimeStackTracePattern,
 	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		uCtx := unreachableStatementErrorCtx{}
+		if loop, semicolon := precedingEmptyBody(m.Nearest); !loop.IsNull() && loop.Type() != "if_statement" {
+			uCtx.emptyLoop = loop
+			uCtx.emptyLoopSemicolon = semicolon
+		}
 
+		m.Context = uCtx
 	},
 	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(unreachableStatementErrorCtx)
+		if !ctx.emptyLoop.IsNull() {
+			header := statementHeader(ctx.emptyLoop)
+			gen.Add("This error occurs because the semicolon after `%s` ends the loop without a body. The loop never stops, so the code after it can never be reached.", header)
+			return
+		}
+
 		gen.Add("This error occurs because there's code after a return statement, which can never be reached as the function has already exited.")
 	},
 	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(unreachableStatementErrorCtx)
+		if !ctx.emptyLoop.IsNull() {
+			gen.Add("Remove the semicolon after the loop", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Remove the `;` after `%s` so that the code after it becomes the body of the loop.", statementHeader(ctx.emptyLoop)).
+					AddFix(lib.FixSuggestion{
+						NewText:       "",
+						StartPosition: ctx.emptyLoopSemicolon.StartPosition(),
+						EndPosition:   ctx.emptyLoopSemicolon.EndPosition(),
+					})
+			})
+			return
+		}
+
 		gen.Add("Remove unreachable code", func(s *lib.BugFixSuggestion) {
 			startPos := cd.MainError.Nearest.StartPosition()
 			endPos := cd.MainError.Nearest.Parent().LastNamedChild().EndPosition()
@@ -0,0 +1,189 @@
+package java
+
+import (
+	"fmt"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+// packages of the common checked exceptions which are not part of java.lang
+var checkedExceptionPackages = map[string]string{
+	"IOException":           "java.io",
+	"FileNotFoundException": "java.io",
+	"EOFException":          "java.io",
+	"MalformedURLException": "java.net",
+	"URISyntaxException":    "java.net",
+	"ParseException":        "java.text",
+	"SQLException":          "java.sql",
+	"TimeoutException":      "java.util.concurrent",
+	"ExecutionException":    "java.util.concurrent",
+}
+
+type unreportedExceptionErrorCtx struct {
+	exception string
+	call      lib.SyntaxNode
+	statement lib.SyntaxNode
+	method    lib.SyntaxNode
+}
+
+var UnreportedExceptionError = lib.ErrorTemplate{
+	Name:              "UnreportedExceptionError",
+	Pattern:           comptimeErrorPattern(`unreported exception (?P<exception>\S+); must be caught or declared to be thrown(?:\n.*\n(?P<caret>[ \t]*)\^)?`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		uCtx := unreportedExceptionErrorCtx{exception: cd.Variables["exception"]}
+		errorLine := m.ErrorNode.StartPos.Line - 1
+		caretCol := len(cd.Variables["caret"])
+		hasCaret := strings.Contains(cd.Variables["message"], "^")
+
+		// the nearest node may start from the line before the error so
+		// look for the calls starting from its parent as well
+		for nearest := m.Nearest; !nearest.IsNull() && uCtx.call.IsNull(); nearest = nearest.Parent() {
+			for q := nearest.Query(`[(method_invocation) (object_creation_expression)] @call`); q.Next(); {
+				node := q.CurrentNode()
+				if node.StartPosition().Line != errorLine {
+					continue
+				}
+
+				// pick the innermost call pointed by the caret (eg. the
+				// FileReader in `new BufferedReader(new FileReader(path))`)
+				if !hasCaret {
+					uCtx.call = node
+					break
+				} else if caretCol >= node.StartPosition().Column && caretCol < node.EndPosition().Column {
+					uCtx.call = node
+				}
+			}
+		}
+
+		if uCtx.call.IsNull() {
+			m.Context = uCtx
+			return
+		}
+
+		m.Nearest = uCtx.call
+
+		for parent := uCtx.call; !parent.IsNull(); parent = parent.Parent() {
+			if uCtx.statement.IsNull() && (parent.Parent().Type() == "block" || parent.Parent().Type() == "constructor_body") {
+				uCtx.statement = parent
+			}
+
+			if parent.Type() == "method_declaration" || parent.Type() == "constructor_declaration" {
+				uCtx.method = parent
+				break
+			} else if parent.Type() == "lambda_expression" || parent.Type() == "class_body" {
+				// lambdas and initializers cannot declare a throws clause
+				break
+			}
+		}
+
+		m.Context = uCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(unreportedExceptionErrorCtx)
+		gen.Add("This error occurs when a method or constructor that may throw a checked exception (`%s`) is called without handling it. ", ctx.exception)
+		gen.Add("Java requires checked exceptions to be either caught with a `try-catch` block or declared in the `throws` clause of the enclosing method.")
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(unreportedExceptionErrorCtx)
+		doc := cd.MainError.Document
+
+		if !ctx.statement.IsNull() {
+			gen.Add("Wrap the call in a try-catch block", func(s *lib.BugFixSuggestion) {
+				startPos := ctx.statement.StartPosition()
+				spaces := getSpaceFromBeginning(doc, startPos.Line, startPos.Column)
+				indent := getIndent(spaces, 1)
+
+				step := s.AddStep(
+					"Surround the statement with a `try-catch` block that handles the `%s` thrown by `%s`.",
+					ctx.exception,
+					callName(ctx.call),
+				)
+				wrapStatements(
+					step, doc, ctx.statement, lastStatementUsingDeclaration(ctx.statement), "try {",
+					fmt.Sprintf("} catch (%s e) {\n%s%se.printStackTrace();\n%s}", ctx.exception, spaces, indent, spaces),
+				)
+				step.Fixes[len(step.Fixes)-1].Description = "This allows the program to recover when the exception is thrown instead of failing to compile."
+
+				addExceptionImportStep(s, doc, ctx.exception)
+			})
+		}
+
+		if !ctx.method.IsNull() {
+			gen.Add("Add a throws clause to the method", func(s *lib.BugFixSuggestion) {
+				name := ctx.method.ChildByFieldName("name").Text()
+				description := fmt.Sprintf("Any code calling `%s` will then have to handle `%s` instead.", name, ctx.exception)
+				if ctx.method.Type() == "constructor_declaration" {
+					description = fmt.Sprintf("Any code creating a `%s` object will then have to handle `%s` instead.", name, ctx.exception)
+				} else if name == "main" {
+					description = fmt.Sprintf("The program will then stop and report the `%s` once it is thrown.", ctx.exception)
+				}
+
+				fix := lib.FixSuggestion{
+					NewText:       fmt.Sprintf(" throws %s", ctx.exception),
+					StartPosition: ctx.method.ChildByFieldName("parameters").EndPosition(),
+					EndPosition:   ctx.method.ChildByFieldName("parameters").EndPosition(),
+					Description:   description,
+				}
+
+				// append to the existing throws clause if there is any
+				for i := 0; i < int(ctx.method.ChildCount()); i++ {
+					if child := ctx.method.Child(i); child.Type() == "throws" {
+						fix.NewText = fmt.Sprintf(", %s", ctx.exception)
+						fix.StartPosition = child.EndPosition()
+						fix.EndPosition = child.EndPosition()
+						break
+					}
+				}
+
+				s.AddStep(
+					"Declare that `%s` may throw `%s` by adding it to the `throws` clause of its signature.",
+					name,
+					ctx.exception,
+				).AddFix(fix)
+
+				addExceptionImportStep(s, doc, ctx.exception)
+			})
+		}
+	},
+}
+
+func callName(call lib.SyntaxNode) string {
+	if call.Type() == "object_creation_expression" {
+		return fmt.Sprintf("new %s()", call.ChildByFieldName("type").Text())
+	}
+	return call.Doc.Contents[call.StartByte():call.ChildByFieldName("arguments").StartByte()] + "()"
+}
+
+// lastStatementUsingDeclaration returns the last statement within the same block
+// that uses the variables declared by the statement so that they remain accessible
+// once the statement is wrapped inside a try block
+func lastStatementUsingDeclaration(statement lib.SyntaxNode) lib.SyntaxNode {
+	if statement.Type() != "local_variable_declaration" {
+		return statement
+	}
+
+	names := map[string]bool{}
+	for q := statement.Query(`(variable_declarator name: (identifier) @name)`); q.Next(); {
+		names[q.CurrentNode().Text()] = true
+	}
+
+	last := statement
+	for sibling := statement.NextSibling(); !sibling.IsNull(); sibling = sibling.NextSibling() {
+		for q := sibling.Query(`(identifier) @identifier`); q.Next(); {
+			if names[q.CurrentNode().Text()] {
+				last = sibling
+				break
+			}
+		}
+	}
+
+	return last
+}
+
+func addExceptionImportStep(s *lib.BugFixSuggestion, doc *lib.Document, exception string) {
+	if pkg, ok := checkedExceptionPackages[exception]; ok {
+		addImportStep(s, doc, pkg, exception)
+	}
+}
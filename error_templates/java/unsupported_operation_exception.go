@@ -0,0 +1,107 @@
+package java
+
+import (
+	"fmt"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type unsupportedOperationExceptionCtx struct {
+	call lib.SyntaxNode
+	// the expression which created the collection (eg. `List.of(1, 2, 3)`)
+	creation lib.SyntaxNode
+}
+
+var UnsupportedOperationException = lib.ErrorTemplate{
+	Name:    "UnsupportedOperationException",
+	Pattern: runtimeErrorPattern("java.lang.UnsupportedOperationException", ""),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := unsupportedOperationExceptionCtx{}
+		ctx.call = nodeOnErrorLine(m, "call", `((method_invocation
+			object: (identifier)
+			name: (identifier) @name) @call
+			(#match? @name "^(add|addAll|remove|removeAll|removeIf|clear|set|put|sort)$"))`)
+
+		if ctx.call.IsNull() {
+			m.Context = ctx
+			return
+		}
+
+		m.Nearest = ctx.call
+		collection := ctx.call.ChildByFieldName("object").Text()
+
+		// look for the declaration or assignment of the collection before the call
+		rootNode := m.Document.RootNode()
+		name := ""
+		for q := rootNode.Query(`[
+			(variable_declarator name: (identifier) @name value: (method_invocation) @value)
+			(assignment_expression left: (identifier) @name right: (method_invocation) @value)
+		]`); q.Next(); {
+			if q.CurrentTagName() == "name" {
+				name = q.CurrentNode().Text()
+				continue
+			}
+
+			value := q.CurrentNode()
+			if name == collection && value.StartByte() < ctx.call.StartByte() && isUnmodifiableCollectionCreation(value) {
+				ctx.creation = value
+			}
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(unsupportedOperationExceptionCtx)
+		gen.Add("This error occurs when trying to modify a collection that does not support the operation.")
+		if ctx.creation.IsNull() {
+			return
+		}
+
+		if strings.HasPrefix(ctx.creation.Text(), "Arrays.asList") {
+			gen.Add(" The list created by `Arrays.asList` has a fixed size since it uses the given array to store its items, so items cannot be added or removed from it.")
+		} else {
+			gen.Add(" `%s` creates an immutable collection which cannot be changed after it is created.", ctx.creation.Text()[:strings.Index(ctx.creation.Text(), "(")])
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(unsupportedOperationExceptionCtx)
+		if ctx.creation.IsNull() {
+			return
+		}
+
+		className := "ArrayList"
+		if creation := ctx.creation.Text(); strings.HasPrefix(creation, "Set.") {
+			className = "HashSet"
+		} else if strings.HasPrefix(creation, "Map.") {
+			className = "HashMap"
+		}
+
+		gen.Add(fmt.Sprintf("Copy the items into a new %s", className), func(s *lib.BugFixSuggestion) {
+			s.AddStep("Create a modifiable `%s` with the same items so that `%s` can change it.", className, ctx.call.Text()).
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("new %s<>(%s)", className, ctx.creation.Text()),
+					StartPosition: ctx.creation.StartPosition(),
+					EndPosition:   ctx.creation.EndPosition(),
+				})
+
+			addImportStep(s, cd.MainError.Document, "java.util", className)
+		})
+	},
+}
+
+func isUnmodifiableCollectionCreation(node lib.SyntaxNode) bool {
+	object := node.ChildByFieldName("object").Text()
+	name := node.ChildByFieldName("name").Text()
+
+	switch {
+	case object == "Arrays" && name == "asList":
+		return true
+	case (object == "List" || object == "Set" || object == "Map") && (name == "of" || name == "copyOf"):
+		return true
+	case object == "Collections" && strings.HasPrefix(name, "unmodifiable"):
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,74 @@
+package java
+
+import (
+	lib "github.com/nedpals/errgoengine"
+)
+
+type variableAlreadyAssignedErrorCtx struct {
+	statement   lib.SyntaxNode
+	declaration lib.SyntaxNode
+	// whether the assignment is inside a loop which assigns the variable more than once
+	inLoop bool
+}
+
+var VariableAlreadyAssignedError = lib.ErrorTemplate{
+	Name:              "VariableAlreadyAssignedError",
+	Pattern:           comptimeErrorPattern(`variable (?P<variable>\S+) might already have been assigned`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		vCtx := variableAlreadyAssignedErrorCtx{}
+		assignment := nodeOnErrorLine(m, "assignment", `(assignment_expression left: [(identifier) (field_access)] @left) @assignment`)
+		if assignment.IsNull() {
+			m.Context = vCtx
+			return
+		}
+
+		m.Nearest = assignment
+		vCtx.statement = assignment.Parent()
+		vCtx.declaration = findVariableDeclaration(cd, m.Document, assignment, cd.Variables["variable"])
+
+		for parent := assignment.Parent(); !parent.IsNull() && parent.Type() != "method_declaration" && parent.Type() != "constructor_declaration"; parent = parent.Parent() {
+			switch parent.Type() {
+			case "for_statement", "enhanced_for_statement", "while_statement", "do_statement":
+				vCtx.inLoop = true
+			}
+		}
+
+		m.Context = vCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(variableAlreadyAssignedErrorCtx)
+		gen.Add("This error occurs when a `final` variable may be assigned more than once. ")
+
+		if ctx.inLoop {
+			gen.Add("`%s` is assigned inside a loop, so it would be assigned again on every iteration.", cd.Variables["variable"])
+		} else {
+			gen.Add("`%s` already has a value at this point, and a `final` variable can only be assigned once.", cd.Variables["variable"])
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(variableAlreadyAssignedErrorCtx)
+		if ctx.statement.IsNull() {
+			return
+		}
+
+		if !ctx.declaration.IsNull() && hasModifier(ctx.declaration, "final") {
+			gen.Add("Remove the final modifier", func(s *lib.BugFixSuggestion) {
+				s.AddStep("If `%s` needs to be assigned more than once, remove `final` from its declaration.", cd.Variables["variable"]).
+					AddFix(removeFinalModifierFix(ctx.declaration))
+			})
+		}
+
+		if !ctx.inLoop && ctx.statement.Type() == "expression_statement" {
+			gen.Add("Remove the extra assignment", func(s *lib.BugFixSuggestion) {
+				startPos := ctx.statement.StartPosition()
+				s.AddStep("Keep only one assignment of `%s` by removing the assignment that comes after it.", cd.Variables["variable"]).
+					AddFix(lib.FixSuggestion{
+						NewText:       "",
+						StartPosition: lib.Position{Line: startPos.Line},
+						EndPosition:   lib.Position{Line: startPos.Line, Column: len(cd.MainError.Document.LineAt(startPos.Line))},
+					})
+			})
+		}
+	},
+}
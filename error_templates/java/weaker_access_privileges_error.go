@@ -0,0 +1,105 @@
+package java
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+var accessModifiers = []string{"public", "protected", "private"}
+
+type weakerAccessPrivilegesErrorCtx struct {
+	method lib.SyntaxNode
+	// the current access modifier of the method. null if the method is package-private
+	modifier lib.SyntaxNode
+}
+
+var WeakerAccessPrivilegesError = lib.ErrorTemplate{
+	Name: "WeakerAccessPrivilegesError",
+	Pattern: comptimeErrorPattern(
+		`(?P<method>[^\s(]+)\((?P<parameters>[^)]*)\) in (?P<class>\S+) cannot (?:override|implement) \S+ in (?P<parent>\S+)`,
+		`attempting to assign weaker access privileges; was (?P<access>\w+)`,
+	),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		wCtx := weakerAccessPrivilegesErrorCtx{}
+		// the declaration may start with an annotation located before the error line
+		name := nodeOnErrorLine(m, "name", `(method_declaration name: (identifier) @name (#eq? @name "%s"))`, cd.Variables["method"])
+		if name.IsNull() {
+			m.Context = wCtx
+			return
+		}
+
+		m.Nearest = name
+		wCtx.method = name.Parent()
+		if modifiers := childOfType(wCtx.method, "modifiers"); !modifiers.IsNull() {
+			for _, modifier := range accessModifiers {
+				if node := childOfType(modifiers, modifier); !node.IsNull() {
+					wCtx.modifier = node
+					break
+				}
+			}
+		}
+
+		m.Context = wCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(weakerAccessPrivilegesErrorCtx)
+		gen.Add("This error occurs when a method that overrides another method is less accessible than the method it overrides. ")
+
+		access := "package-private"
+		if !ctx.modifier.IsNull() {
+			access = ctx.modifier.Text()
+		}
+
+		gen.Add(
+			"`%s` is `%s` in `%s`, but it is `%s` in `%s`.",
+			cd.Variables["method"],
+			cd.Variables["access"],
+			simpleClassName(cd.Variables["parent"]),
+			access,
+			simpleClassName(cd.Variables["class"]),
+		)
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(weakerAccessPrivilegesErrorCtx)
+		if ctx.method.IsNull() {
+			return
+		}
+
+		access := cd.Variables["access"]
+		gen.Add(fmt.Sprintf("Make the method %s", access), func(s *lib.BugFixSuggestion) {
+			step := s.AddStep(
+				"Use the same access modifier as the `%s` method of `%s`.",
+				cd.Variables["method"],
+				simpleClassName(cd.Variables["parent"]),
+			)
+
+			if !ctx.modifier.IsNull() {
+				step.AddFix(lib.FixSuggestion{
+					NewText:       access,
+					StartPosition: ctx.modifier.StartPosition(),
+					EndPosition:   ctx.modifier.EndPosition(),
+				})
+				return
+			}
+
+			// add the modifier before the other modifiers (except annotations) or the return type
+			insertPos := ctx.method.ChildByFieldName("type").StartPosition()
+			if modifiers := childOfType(ctx.method, "modifiers"); !modifiers.IsNull() {
+				for i := 0; i < int(modifiers.ChildCount()); i++ {
+					if modifier := modifiers.Child(i); modifier.Type() != "marker_annotation" && modifier.Type() != "annotation" {
+						insertPos = modifier.StartPosition()
+						break
+					}
+				}
+			}
+
+			step.AddFix(lib.FixSuggestion{
+				NewText:       access + " ",
+				StartPosition: insertPos,
+				EndPosition:   insertPos,
+			})
+		})
+	},
+}
@@ -0,0 +1,61 @@
+package jvm
+
+import (
+	"regexp"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+// a division or a remainder by the literal zero (eg. `total / 0`)
+var divisionByZeroRegex = regexp.MustCompile(`[/%]\s*(0)(?:[^.\w]|$)`)
+
+type arithmeticExceptionCtx struct {
+	// the zero literal which the number is divided by
+	divisor lib.SyntaxNode
+}
+
+var ArithmeticException = lib.ErrorTemplate{
+	Name:    "ArithmeticException",
+	Pattern: runtimeErrorPattern("java.lang.ArithmeticException", "(?P<reason>.+)"),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := arithmeticExceptionCtx{}
+		if cd.Variables["reason"] == "/ by zero" {
+			if ctx.divisor = nodeOnErrorLine(m, divisionByZeroRegex, 1); !ctx.divisor.IsNull() {
+				m.Nearest = ctx.divisor
+			}
+		}
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		reason := cd.Variables["reason"]
+		if reason != "/ by zero" {
+			gen.Add("This error is raised when an arithmetic operation cannot produce a valid result (`%s`).", reason)
+			return
+		}
+
+		gen.Add("This error is raised when a whole number is divided by zero, which does not have a result.")
+		if ctx := cd.MainError.Context.(arithmeticExceptionCtx); ctx.divisor.IsNull() {
+			gen.Add(" The number on the right side of the division or the remainder became zero before the operation.")
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(arithmeticExceptionCtx)
+		if cd.Variables["reason"] != "/ by zero" {
+			return
+		}
+
+		gen.Add("Avoid dividing by zero", func(s *lib.BugFixSuggestion) {
+			if ctx.divisor.IsNull() {
+				s.AddStep("Check that the divisor is not zero before dividing by it.")
+				return
+			}
+
+			s.AddStep("Divide by a number which is not zero.").
+				AddFix(lib.FixSuggestion{
+					NewText:       "1",
+					StartPosition: ctx.divisor.StartPosition(),
+					EndPosition:   ctx.divisor.EndPosition(),
+				})
+		})
+	},
+}
@@ -0,0 +1,74 @@
+package jvm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+// LoadErrorTemplates adds the templates of the exceptions thrown by the JVM for
+// the language whose source files have the given extension (eg. "kt"). Templates
+// of the language with the same name which are added before (eg. ones with fixes
+// for its own syntax) are kept.
+func LoadErrorTemplates(errorTemplates *lib.ErrorTemplates, language *lib.Language, fileExt string) {
+	for _, template := range []lib.ErrorTemplate{
+		ArithmeticException,
+		ArrayIndexOutOfBoundsException,
+		NumberFormatException,
+	} {
+		template.Pattern = strings.ReplaceAll(template.Pattern, fileExtPlaceholder, fileExt)
+		errorTemplates.MustAdd(language, template)
+	}
+}
+
+const stackFramePattern = `\s+at \S+\([^)]*\)`
+
+// replaced with the extension of the source files once the templates are loaded
+const fileExtPlaceholder = "$fileExt"
+
+// RuntimeErrorPattern returns the pattern for uncaught exceptions thrown
+// by the JVM. Languages running on the JVM share the same stack trace format
+// so the stack trace must include at least one frame from a source file with
+// the given extension (eg. "java" or "kt") to tell the languages apart. Frames
+// of the JDK modules (eg. `java.base/java.lang.Integer.parseInt`) are skipped
+// since they come from Java sources regardless of the language of the program.
+func RuntimeErrorPattern(fileExt string, errorName string, pattern string) string {
+	p := fmt.Sprintf(
+		`Exception in thread "(?P<thread>\w+)" %s`,
+		strings.ReplaceAll(errorName, ".", `\.`),
+	)
+	if len(pattern) != 0 {
+		p += ": " + pattern
+	}
+	return lib.CustomErrorPattern(p + fmt.Sprintf(
+		`(?P<stacktrace>(?:%s)*\s+at [^\s/]+\(\S+\.%s:\d+\)(?:%s)*)`,
+		stackFramePattern, fileExt, stackFramePattern,
+	))
+}
+
+func runtimeErrorPattern(errorName string, pattern string) string {
+	return RuntimeErrorPattern(fileExtPlaceholder, errorName, pattern)
+}
+
+// errorLine returns the index of the line where the exception was thrown
+func errorLine(m *lib.MainError) int {
+	return m.ErrorNode.StartPos.Line - 1
+}
+
+// nodeOnErrorLine returns the node of the text matched by the group of the
+// pattern on the line of the error. The text is used instead of the syntax
+// tree since the node types differ between the languages running on the JVM.
+func nodeOnErrorLine(m *lib.MainError, pattern *regexp.Regexp, group int) lib.SyntaxNode {
+	line := errorLine(m)
+	loc := pattern.FindStringSubmatchIndex(m.Document.LineAt(line))
+	if loc == nil || loc[group*2] == -1 {
+		return lib.SyntaxNode{}
+	}
+
+	return m.Document.RootNode().NamedDescendantForPointRange(lib.Location{
+		StartPos: lib.Position{Line: line, Column: loc[group*2]},
+		EndPos:   lib.Position{Line: line, Column: loc[group*2+1]},
+	})
+}
@@ -0,0 +1,67 @@
+package jvm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type numberFormatExceptionCtx struct {
+	// the string literal with the value which cannot be converted
+	literal lib.SyntaxNode
+}
+
+var NumberFormatException = lib.ErrorTemplate{
+	Name:    "NumberFormatException",
+	Pattern: runtimeErrorPattern("java.lang.NumberFormatException", `For input string: "(?P<string>.*)"`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := numberFormatExceptionCtx{}
+		literalRegex := regexp.MustCompile(`"` + regexp.QuoteMeta(cd.Variables["string"]) + `"`)
+
+		// the value is either converted on the line of the error (eg. `"abc".toInt()`)
+		// or stored in a variable before it (eg. `String input = "abc";`)
+		if ctx.literal = nodeOnErrorLine(m, literalRegex, 0); !ctx.literal.IsNull() {
+			m.Nearest = ctx.literal
+		} else {
+			for line := errorLine(m) - 1; line >= 0; line-- {
+				if loc := literalRegex.FindStringIndex(m.Document.LineAt(line)); loc != nil {
+					ctx.literal = m.Document.RootNode().NamedDescendantForPointRange(lib.Location{
+						StartPos: lib.Position{Line: line, Column: loc[0]},
+						EndPos:   lib.Position{Line: line, Column: loc[1]},
+					})
+					break
+				}
+			}
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		gen.Add("This error occurs when there is an attempt to convert a string to a numeric type, but the string does not represent a valid number.")
+		if value := cd.Variables["string"]; len(strings.TrimSpace(value)) == 0 {
+			gen.Add(" The string is empty, so it does not have any digits to convert.")
+		} else {
+			gen.Add(" `%s` cannot be read as a number.", value)
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(numberFormatExceptionCtx)
+		if ctx.literal.IsNull() {
+			gen.Add("Ensure valid input for parsing", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Make sure that the string only contains digits before converting it, or handle the `NumberFormatException` when it does not.")
+			})
+			return
+		}
+
+		gen.Add("Ensure valid input for parsing", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Make sure the string contains a valid numeric representation before attempting to parse it.").
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("%q", "123"),
+					StartPosition: ctx.literal.StartPosition(),
+					EndPosition:   ctx.literal.EndPosition(),
+				})
+		})
+	},
+}
@@ -0,0 +1,96 @@
+package kotlin
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/error_templates/jvm"
+	"github.com/nedpals/errgoengine/languages/kotlin"
+)
+
+func LoadErrorTemplates(errorTemplates *lib.ErrorTemplates) {
+	// Runtime
+	errorTemplates.MustAdd(kotlin.Language, KotlinNullPointerException)
+
+	// Compile time
+	errorTemplates.MustAdd(kotlin.Language, UnresolvedReferenceError)
+	errorTemplates.MustAdd(kotlin.Language, TypeMismatchError)
+	errorTemplates.MustAdd(kotlin.Language, UnsafeCallError)
+
+	// Shared with the other languages running on the JVM
+	jvm.LoadErrorTemplates(errorTemplates, kotlin.Language, "kt")
+}
+
+func runtimeErrorPattern(errorName string, pattern string) string {
+	return jvm.RuntimeErrorPattern("kt", errorName, pattern)
+}
+
+// compile errors from kotlinc are in the form of
+// Main.kt:3:5: error: <message>
+const comptimeStackTracePattern = `(?P<path>\S+\.kt):(?P<position>\d+:\d+)`
+
+func comptimeErrorPattern(pattern string) string {
+	return fmt.Sprintf(`$stacktrace: error: %s.*`, pattern)
+}
+
+// newer versions of kotlinc (K2) print the fully qualified
+// names of the builtin types (eg. `kotlin.String`)
+func simplifyTypeName(name string) string {
+	return strings.TrimPrefix(name, "kotlin.")
+}
+
+// nodeAtErrorPos returns the node located at the position reported by the compiler
+func nodeAtErrorPos(m *lib.MainError) lib.SyntaxNode {
+	pos := lib.Position{Line: m.ErrorNode.StartPos.Line - 1, Column: m.ErrorNode.StartPos.Column}
+	return m.Document.RootNode().NamedDescendantForPointRange(lib.Location{
+		StartPos: pos,
+		EndPos:   pos,
+	})
+}
+
+func getDefaultValueForType(sym lib.Symbol) string {
+	if nSym, ok := sym.(kotlin.NullableSymbol); ok {
+		sym = nSym.Symbol
+	}
+
+	switch sym {
+	case kotlin.BuiltinTypes.IntSymbol:
+		return "0"
+	case kotlin.BuiltinTypes.LongSymbol:
+		return "0L"
+	case kotlin.BuiltinTypes.DoubleSymbol:
+		return "0.0"
+	case kotlin.BuiltinTypes.FloatSymbol:
+		return "0.0f"
+	case kotlin.BuiltinTypes.BooleanSymbol:
+		return "false"
+	case kotlin.BuiltinTypes.CharSymbol:
+		return "' '"
+	case kotlin.BuiltinTypes.StringSymbol:
+		return "\"example\""
+	default:
+		return "null"
+	}
+}
+
+func getSpaceFromBeginning(doc *lib.Document, line int) string {
+	lineStr := doc.LineAt(line)
+	return lineStr[:len(lineStr)-len(strings.TrimLeftFunc(lineStr, unicode.IsSpace))]
+}
+
+// getStatementNode returns the nearest statement that contains the node
+func getStatementNode(node lib.SyntaxNode) lib.SyntaxNode {
+	current := node
+	for !current.IsNull() {
+		if parent := current.Parent(); parent.IsNull() || parent.Type() == "statements" {
+			break
+		}
+		current = current.Parent()
+	}
+	if current.IsNull() {
+		return node
+	}
+	return current
+}
@@ -0,0 +1,104 @@
+package kotlin
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type kotlinNullPointerExceptionCtx struct {
+	origin       string
+	assertedNode lib.SyntaxNode
+	operatorNode lib.SyntaxNode
+}
+
+var KotlinNullPointerException = lib.ErrorTemplate{
+	Name: "KotlinNullPointerException",
+	// older versions of kotlin throw `KotlinNullPointerException` on `!!` while
+	// newer ones throw the usual `NullPointerException` from the JVM instead
+	Pattern: runtimeErrorPattern("(?:kotlin.KotlinNullPointerException|java.lang.NullPointerException)", ""),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := kotlinNullPointerExceptionCtx{}
+
+		for q := m.Nearest.Query(`(postfix_expression (_) @value "!!" @operator)`); q.Next(); {
+			if q.CurrentTagName() == "value" {
+				ctx.assertedNode = q.CurrentNode()
+				continue
+			}
+
+			ctx.operatorNode = q.CurrentNode()
+			break
+		}
+
+		if !ctx.assertedNode.IsNull() {
+			ctx.origin = ctx.assertedNode.Text()
+			m.Nearest = ctx.assertedNode
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(kotlinNullPointerExceptionCtx)
+		if len(ctx.origin) != 0 {
+			gen.Add("The error occurs because the non-null assertion operator (`!!`) was used on `%s`, which is `null`.", ctx.origin)
+			return
+		}
+
+		gen.Add("This error occurs when your program uses a value that is `null` where a non-null value is required, most commonly when the non-null assertion operator (`!!`) is used on a `null` value.")
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(kotlinNullPointerExceptionCtx)
+		if ctx.operatorNode.IsNull() {
+			return
+		}
+
+		// `a!!.b` becomes `a?.b` while a plain `a!!` uses the elvis operator instead
+		if parent := ctx.assertedNode.Parent().Parent(); parent.Type() == "navigation_expression" {
+			gen.Add("Use a safe call", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Replace the non-null assertion with the safe call operator (`?.`) so that the expression returns `null` instead of throwing an error when `%s` is `null`.", ctx.origin).
+					AddFix(lib.FixSuggestion{
+						NewText:       "?",
+						StartPosition: ctx.operatorNode.StartPosition(),
+						EndPosition:   ctx.operatorNode.EndPosition(),
+					})
+			})
+		} else {
+			gen.Add("Provide a default value", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Use the elvis operator (`?:`) to provide a default value when `%s` is `null`.", ctx.origin).
+					AddFix(lib.FixSuggestion{
+						NewText:       fmt.Sprintf("(%s ?: %s)", ctx.origin, getDefaultValueForType(lib.UnwrapReturnType(cd.FindSymbol(ctx.origin, ctx.assertedNode.StartPosition().Index)))),
+						StartPosition: ctx.assertedNode.StartPosition(),
+						EndPosition:   ctx.operatorNode.EndPosition(),
+					})
+			})
+		}
+
+		gen.Add("Initialize the variable", func(s *lib.BugFixSuggestion) {
+			varSym := cd.FindSymbol(ctx.origin, ctx.assertedNode.StartPosition().Index)
+			if varSym == nil {
+				return
+			}
+
+			// look for the `null` value from the declaration
+			declNode := cd.MainError.Document.RootNode().NamedDescendantForPointRange(varSym.Location())
+			for !declNode.IsNull() && declNode.Type() != "property_declaration" {
+				declNode = declNode.Parent()
+			}
+			if declNode.IsNull() {
+				return
+			}
+
+			valueNode := declNode.Child(int(declNode.ChildCount()) - 1)
+			if valueNode.Type() != "null" {
+				return
+			}
+
+			s.AddStep("An alternative fix is to initialize the `%s` variable with a non-null value before using it.", ctx.origin).
+				AddFix(lib.FixSuggestion{
+					NewText:       getDefaultValueForType(lib.UnwrapReturnType(varSym)),
+					StartPosition: valueNode.StartPosition(),
+					EndPosition:   valueNode.EndPosition(),
+				})
+		})
+	},
+}
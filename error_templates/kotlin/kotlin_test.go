@@ -0,0 +1,15 @@
+package kotlin_test
+
+import (
+	"testing"
+
+	"github.com/nedpals/errgoengine/error_templates/kotlin"
+	testutils "github.com/nedpals/errgoengine/error_templates/test_utils"
+)
+
+func TestKotlinErrorTemplates(t *testing.T) {
+	testutils.SetupTest(t, testutils.SetupTestConfig{
+		DirName:        "kotlin",
+		TemplateLoader: kotlin.LoadErrorTemplates,
+	}).Execute(t)
+}
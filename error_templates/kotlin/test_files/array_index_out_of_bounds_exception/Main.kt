@@ -0,0 +1,4 @@
+fun main() {
+    val nums = intArrayOf(1, 2, 3)
+    println(nums[5])
+}
@@ -0,0 +1,4 @@
+fun main() {
+    val input = "abc"
+    println(input.toInt())
+}
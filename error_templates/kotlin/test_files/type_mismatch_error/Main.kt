@@ -0,0 +1,4 @@
+fun main() {
+    val age: Int = "25"
+    println(age)
+}
@@ -0,0 +1,4 @@
+fun main() {
+    val count = 5
+    println(cuont)
+}
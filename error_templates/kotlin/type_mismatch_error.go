@@ -0,0 +1,108 @@
+package kotlin
+
+import (
+	"fmt"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type typeMismatchErrorCtx struct {
+	actualType   string
+	expectedType string
+	valueNode    lib.SyntaxNode
+	typeNode     lib.SyntaxNode
+}
+
+var TypeMismatchError = lib.ErrorTemplate{
+	Name: "TypeMismatchError",
+	Pattern: comptimeErrorPattern(
+		`(?:[a-zA-Z]+ )?[tT]ype mismatch: (?:` +
+			`inferred type is (?P<actualType>\S+) but (?P<expectedType>\S+) was expected|` +
+			`expected '(?P<expectedType>[^']+)', actual '(?P<actualType>[^']+)'|` +
+			`actual type is '(?P<actualType>[^']+)',? but '(?P<expectedType>[^']+)' was expected)`,
+	),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := typeMismatchErrorCtx{
+			actualType:   simplifyTypeName(cd.Variables["actualType"]),
+			expectedType: simplifyTypeName(cd.Variables["expectedType"]),
+		}
+
+		// the compiler points to the start of the offending expression
+		// so we go up until we get the whole expression
+		node := nodeAtErrorPos(m)
+		for parent := node.Parent(); !parent.IsNull() && parent.StartByte() == node.StartByte() && !isValueHolderNode(parent); parent = node.Parent() {
+			node = parent
+		}
+
+		if !node.IsNull() {
+			ctx.valueNode = node
+			m.Nearest = node
+
+			if parent := node.Parent(); parent.Type() == "property_declaration" {
+				if declNode := parent.NamedChild(0); declNode.Type() == "variable_declaration" && declNode.NamedChildCount() > 1 {
+					ctx.typeNode = declNode.NamedChild(1)
+				}
+			}
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(typeMismatchErrorCtx)
+		gen.Add(
+			"This error occurs when you use a value of type `%s` where a `%s` is expected. Kotlin does not convert between types automatically.",
+			ctx.actualType,
+			ctx.expectedType,
+		)
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(typeMismatchErrorCtx)
+		if ctx.valueNode.IsNull() {
+			return
+		}
+
+		if converted := convertValue(ctx.valueNode.Text(), ctx.expectedType); len(converted) != 0 {
+			gen.Add("Convert the value", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Convert the value from `%s` to `%s` explicitly.", ctx.actualType, ctx.expectedType).
+					AddFix(lib.FixSuggestion{
+						NewText:       converted,
+						StartPosition: ctx.valueNode.StartPosition(),
+						EndPosition:   ctx.valueNode.EndPosition(),
+					})
+			})
+		}
+
+		if !ctx.typeNode.IsNull() {
+			gen.Add("Change the variable type", func(s *lib.BugFixSuggestion) {
+				s.AddStep("If the variable is meant to hold a `%s`, change its type to `%s`.", ctx.actualType, ctx.actualType).
+					AddFix(lib.FixSuggestion{
+						NewText:       ctx.actualType,
+						StartPosition: ctx.typeNode.StartPosition(),
+						EndPosition:   ctx.typeNode.EndPosition(),
+					})
+			})
+		}
+	},
+}
+
+// isValueHolderNode checks if the node is the one holding the expression (eg. `val a: Int = <value>`)
+func isValueHolderNode(node lib.SyntaxNode) bool {
+	switch node.Type() {
+	case "property_declaration", "assignment", "value_argument", "jump_expression", "statements":
+		return true
+	}
+	return false
+}
+
+func convertValue(value string, to string) string {
+	switch to {
+	case "String", "Int", "Long", "Double", "Float":
+		if strings.ContainsRune(value, ' ') {
+			value = "(" + value + ")"
+		}
+		return fmt.Sprintf("%s.to%s()", value, to)
+	}
+	return ""
+}
@@ -0,0 +1,79 @@
+package kotlin
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/utils/levenshtein"
+)
+
+type unresolvedReferenceErrorCtx struct {
+	similarName string
+}
+
+var UnresolvedReferenceError = lib.ErrorTemplate{
+	Name:              "UnresolvedReferenceError",
+	Pattern:           comptimeErrorPattern(`[uU]nresolved reference(?:: (?P<name>\S+)| '(?P<name>[^']+)'\.?)`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := unresolvedReferenceErrorCtx{}
+		name := cd.Variables["name"]
+
+		if node := nodeAtErrorPos(m); node.Type() == "simple_identifier" && node.Text() == name {
+			m.Nearest = node
+		} else {
+			for q := m.Nearest.Query(`((simple_identifier) @name (#eq? @name "%s"))`, name); q.Next(); {
+				m.Nearest = q.CurrentNode()
+				break
+			}
+		}
+
+		// look for a similar name in the current scope (eg. typos)
+		nearestTree := cd.InitOrGetSymbolTree(cd.MainDocumentPath()).GetNearestScopedTree(m.Nearest.StartPosition().Index)
+		nearestDistance := -1
+		for _, sym := range nearestTree.FindSymbolsByClause(func(sym lib.Symbol) bool {
+			return sym.Kind() == lib.SymbolKindVariable || sym.Kind() == lib.SymbolKindFunction
+		}) {
+			distance := levenshtein.ComputeDistance(name, sym.Name())
+			if distance > 2 || (nearestDistance != -1 && distance >= nearestDistance) {
+				continue
+			}
+
+			ctx.similarName = sym.Name()
+			nearestDistance = distance
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		gen.Add("This error occurs when you use a name (`%s`) that Kotlin cannot find. It may be misspelled or not declared in the current scope.", cd.Variables["name"])
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(unresolvedReferenceErrorCtx)
+
+		if len(ctx.similarName) != 0 {
+			gen.Add("Use the correct name", func(s *lib.BugFixSuggestion) {
+				s.AddStep("There is a declaration named `%s` which is similar to `%s`. Check if you misspelled the name.", ctx.similarName, cd.Variables["name"]).
+					AddFix(lib.FixSuggestion{
+						NewText:       ctx.similarName,
+						StartPosition: cd.MainError.Nearest.StartPosition(),
+						EndPosition:   cd.MainError.Nearest.EndPosition(),
+					})
+			})
+		}
+
+		gen.Add("Declare the variable before using it", func(s *lib.BugFixSuggestion) {
+			statement := getStatementNode(cd.MainError.Nearest)
+			startPos := statement.StartPosition()
+			spaces := getSpaceFromBeginning(cd.MainError.Document, startPos.Line)
+
+			s.AddStep("Make sure to declare the variable `%s` before using it.", cd.Variables["name"]).
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("val %s = 0\n%s", cd.Variables["name"], spaces),
+					StartPosition: startPos,
+					EndPosition:   startPos,
+					Description:   "Replace `0` with the value that you need.",
+				})
+		})
+	},
+}
@@ -0,0 +1,91 @@
+package kotlin
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type unsafeCallErrorCtx struct {
+	receiverNode lib.SyntaxNode
+	operatorNode lib.SyntaxNode
+}
+
+var UnsafeCallError = lib.ErrorTemplate{
+	Name:              "UnsafeCallError",
+	Pattern:           comptimeErrorPattern(`[oO]nly safe \(\?\.\) or non-null asserted \(!!\.\) calls are allowed on a nullable receiver of type (?:'(?P<type>[^']+)'|(?P<type>\S+))`),
+	StackTracePattern: comptimeStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := unsafeCallErrorCtx{}
+		errorPos := lib.Position{Line: m.ErrorNode.StartPos.Line - 1, Column: m.ErrorNode.StartPos.Column}
+
+		for q := m.Nearest.Query(`(navigation_expression (_) @receiver (navigation_suffix "." @operator))`); q.Next(); {
+			if q.CurrentTagName() == "receiver" {
+				ctx.receiverNode = q.CurrentNode()
+				continue
+			}
+
+			ctx.operatorNode = q.CurrentNode()
+			if ctx.operatorNode.StartPosition().Eq2(errorPos) {
+				break
+			}
+		}
+
+		if !ctx.receiverNode.IsNull() {
+			m.Nearest = ctx.receiverNode
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(unsafeCallErrorCtx)
+		if !ctx.receiverNode.IsNull() {
+			gen.Add("This error occurs because `%s` has a nullable type (`%s`) and may be `null`. ", ctx.receiverNode.Text(), simplifyTypeName(cd.Variables["type"]))
+		}
+		gen.Add("Kotlin does not allow accessing members of a nullable value directly in order to prevent `NullPointerException` errors.")
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(unsafeCallErrorCtx)
+		if ctx.operatorNode.IsNull() {
+			return
+		}
+
+		gen.Add("Use a safe call", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Use the safe call operator (`?.`) so that the expression returns `null` instead of failing when `%s` is `null`.", ctx.receiverNode.Text()).
+				AddFix(lib.FixSuggestion{
+					NewText:       "?.",
+					StartPosition: ctx.operatorNode.StartPosition(),
+					EndPosition:   ctx.operatorNode.EndPosition(),
+				})
+		})
+
+		if ctx.receiverNode.Type() == "simple_identifier" {
+			gen.Add("Check for null before using it", func(s *lib.BugFixSuggestion) {
+				statement := getStatementNode(ctx.receiverNode)
+				startPos := statement.StartPosition()
+				spaces := getSpaceFromBeginning(cd.MainError.Document, startPos.Line)
+
+				s.AddStep("Check if `%s` is not `null` before using it. Kotlin will then treat it as a non-null value inside the `if` block.", ctx.receiverNode.Text()).
+					AddFix(lib.FixSuggestion{
+						NewText:       fmt.Sprintf("if (%s != null) {\n%s    ", ctx.receiverNode.Text(), spaces),
+						StartPosition: startPos,
+						EndPosition:   startPos,
+					}).
+					AddFix(lib.FixSuggestion{
+						NewText:       fmt.Sprintf("\n%s}", spaces),
+						StartPosition: statement.EndPosition(),
+						EndPosition:   statement.EndPosition(),
+					})
+			})
+		}
+
+		gen.Add("Use a non-null assertion", func(s *lib.BugFixSuggestion) {
+			s.AddStep("If you are sure that `%s` will never be `null` at this point, use the non-null assertion operator (`!!.`). Note that this throws a `NullPointerException` if the value turns out to be `null`.", ctx.receiverNode.Text()).
+				AddFix(lib.FixSuggestion{
+					NewText:       "!!.",
+					StartPosition: ctx.operatorNode.StartPosition(),
+					EndPosition:   ctx.operatorNode.EndPosition(),
+				})
+		})
+	},
+}
@@ -0,0 +1,62 @@
+package php
+
+import (
+	lib "github.com/nedpals/errgoengine"
+)
+
+type memberFunctionOnNullErrorCtx struct {
+	objectNode lib.SyntaxNode
+	nameNode   lib.SyntaxNode
+}
+
+var MemberFunctionOnNullError = lib.ErrorTemplate{
+	Name:    "MemberFunctionOnNullError",
+	Pattern: `Fatal error: +Uncaught Error: Call to a member function (?P<method>\w+)\(\) on null`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := memberFunctionOnNullErrorCtx{}
+
+		for q := getErrorLineNode(m).Query(`(member_call_expression object: (_) @object name: (name) @name (#eq? @name "%s"))`, cd.Variables["method"]); q.Next(); {
+			if q.CurrentTagName() == "object" {
+				ctx.objectNode = q.CurrentNode()
+				continue
+			}
+
+			ctx.nameNode = q.CurrentNode()
+			m.Nearest = ctx.objectNode
+			break
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(memberFunctionOnNullErrorCtx)
+		if !ctx.objectNode.IsNull() {
+			gen.Add("This error occurs because you are calling the method `%s()` on `%s` which is `null`. ", cd.Variables["method"], ctx.objectNode.Text())
+		}
+		gen.Add("Methods can only be called on objects, which usually means that a value was not set or a function returned `null` unexpectedly.")
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(memberFunctionOnNullErrorCtx)
+		if ctx.objectNode.IsNull() || ctx.nameNode.IsNull() {
+			return
+		}
+
+		gen.Add("Use the nullsafe operator", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Use the nullsafe operator (`?->`) so that the method is only called when `%s` is not `null`. This requires PHP 8.0 or later.", ctx.objectNode.Text()).
+				AddFix(lib.FixSuggestion{
+					NewText:       "?->",
+					StartPosition: ctx.objectNode.EndPosition(),
+					EndPosition:   ctx.nameNode.StartPosition(),
+				})
+		})
+
+		gen.Add("Check for null before using it", func(s *lib.BugFixSuggestion) {
+			addWrapInIfFix(
+				s.AddStep("Check if `%s` is not `null` before calling its methods.", ctx.objectNode.Text()),
+				cd.MainError.Document,
+				ctx.objectNode,
+				ctx.objectNode.Text()+" !== null",
+			)
+		})
+	},
+}
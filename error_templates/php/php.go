@@ -0,0 +1,73 @@
+package php
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/languages/php"
+)
+
+func LoadErrorTemplates(errorTemplates *lib.ErrorTemplates) {
+	errorTemplates.MustAdd(php.Language, UndefinedVariableError)
+	errorTemplates.MustAdd(php.Language, UndefinedIndexError)
+	errorTemplates.MustAdd(php.Language, MemberFunctionOnNullError)
+}
+
+func getSpaceFromBeginning(doc *lib.Document, line int) string {
+	lineStr := doc.LineAt(line)
+	return lineStr[:len(lineStr)-len(strings.TrimLeftFunc(lineStr, unicode.IsSpace))]
+}
+
+// getStatementNode returns the nearest statement that contains the node
+func getStatementNode(node lib.SyntaxNode) lib.SyntaxNode {
+	current := node
+	for !current.IsNull() {
+		parent := current.Parent()
+		if parent.IsNull() {
+			break
+		}
+
+		switch parent.Type() {
+		case "program", "compound_statement", "declaration_list", "colon_block", "case_statement", "default_statement":
+			return current
+		}
+
+		current = parent
+	}
+	return node
+}
+
+// getErrorLineNode returns the statement located at the line reported by the error message
+func getErrorLineNode(m *lib.MainError) lib.SyntaxNode {
+	line := m.ErrorNode.StartPos.Line - 1
+	pos := lib.Position{Line: line, Column: len(getSpaceFromBeginning(m.Document, line))}
+	node := m.Document.RootNode().NamedDescendantForPointRange(lib.Location{
+		StartPos: pos,
+		EndPos:   pos,
+	})
+	if node.IsNull() {
+		return m.Nearest
+	}
+	return getStatementNode(node)
+}
+
+// addWrapInIfFix wraps the statement that contains the node inside an if block
+func addWrapInIfFix(step *lib.BugFixStep, doc *lib.Document, node lib.SyntaxNode, condition string) *lib.BugFixStep {
+	statement := getStatementNode(node)
+	startPos := statement.StartPosition()
+	spaces := getSpaceFromBeginning(doc, startPos.Line)
+
+	return step.
+		AddFix(lib.FixSuggestion{
+			NewText:       fmt.Sprintf("if (%s) {\n%s    ", condition, spaces),
+			StartPosition: startPos,
+			EndPosition:   startPos,
+		}).
+		AddFix(lib.FixSuggestion{
+			NewText:       fmt.Sprintf("\n%s}", spaces),
+			StartPosition: statement.EndPosition(),
+			EndPosition:   statement.EndPosition(),
+		})
+}
@@ -0,0 +1,15 @@
+package php_test
+
+import (
+	"testing"
+
+	"github.com/nedpals/errgoengine/error_templates/php"
+	testutils "github.com/nedpals/errgoengine/error_templates/test_utils"
+)
+
+func TestPHPErrorTemplates(t *testing.T) {
+	testutils.SetupTest(t, testutils.SetupTestConfig{
+		DirName:        "php",
+		TemplateLoader: php.LoadErrorTemplates,
+	}).Execute(t)
+}
@@ -0,0 +1,13 @@
+<?php
+class User {
+    public function getName(): string {
+        return "John";
+    }
+}
+
+function findUser(int $id): ?User {
+    return null;
+}
+
+$user = findUser(1);
+echo $user->getName();
@@ -0,0 +1,3 @@
+<?php
+$user = ["name" => "John"];
+echo $user["email"];
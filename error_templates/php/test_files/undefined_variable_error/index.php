@@ -0,0 +1,4 @@
+<?php
+$count = 5;
+$total = $cuont + 1;
+echo $total;
@@ -0,0 +1,72 @@
+package php
+
+import (
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type undefinedIndexErrorCtx struct {
+	subscriptNode lib.SyntaxNode
+	arrayNode     lib.SyntaxNode
+}
+
+var UndefinedIndexError = lib.ErrorTemplate{
+	Name: "UndefinedIndexError",
+	// PHP 8 reports "Undefined array key" while older versions report
+	// either "Undefined index" (for strings) or "Undefined offset" (for integers)
+	Pattern: `(?:Warning|Notice): +Undefined (?:array key|index:|offset:) (?:"(?P<key>[^"]*)"|(?P<key>\S+))`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := undefinedIndexErrorCtx{}
+
+		for q := getErrorLineNode(m).Query(`(subscript_expression (_) @array (_) @index) @subscript`); q.Next(); {
+			switch q.CurrentTagName() {
+			case "subscript":
+				ctx.subscriptNode = q.CurrentNode()
+			case "array":
+				ctx.arrayNode = q.CurrentNode()
+			case "index":
+				if strings.Trim(q.CurrentNode().Text(), `"'`) == cd.Variables["key"] {
+					m.Nearest = ctx.subscriptNode
+					m.Context = ctx
+					return
+				}
+			}
+		}
+
+		m.Context = undefinedIndexErrorCtx{}
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(undefinedIndexErrorCtx)
+		if !ctx.arrayNode.IsNull() {
+			gen.Add("This error occurs because you are accessing the key `%s` which does not exist in the array `%s`.", cd.Variables["key"], ctx.arrayNode.Text())
+			return
+		}
+		gen.Add("This error occurs because you are accessing the key `%s` which does not exist in the array.", cd.Variables["key"])
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(undefinedIndexErrorCtx)
+		if ctx.subscriptNode.IsNull() {
+			return
+		}
+
+		gen.Add("Provide a default value", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Use the null coalescing operator (`??`) to fall back to a default value when the key does not exist.").
+				AddFix(lib.FixSuggestion{
+					NewText:       " ?? null",
+					StartPosition: ctx.subscriptNode.EndPosition(),
+					EndPosition:   ctx.subscriptNode.EndPosition(),
+					Description:   "Replace `null` with the default value that you need.",
+				})
+		})
+
+		gen.Add("Check if the key exists", func(s *lib.BugFixSuggestion) {
+			addWrapInIfFix(
+				s.AddStep("Check if the key exists with `isset` before accessing it."),
+				cd.MainError.Document,
+				ctx.subscriptNode,
+				"isset("+ctx.subscriptNode.Text()+")",
+			)
+		})
+	},
+}
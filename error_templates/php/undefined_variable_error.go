@@ -0,0 +1,75 @@
+package php
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/utils/levenshtein"
+)
+
+type undefinedVariableErrorCtx struct {
+	similarName string
+}
+
+var UndefinedVariableError = lib.ErrorTemplate{
+	Name:    "UndefinedVariableError",
+	Pattern: `(?:Warning|Notice): +Undefined variable:? \$?(?P<variable>\w+)`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := undefinedVariableErrorCtx{}
+		name := "$" + cd.Variables["variable"]
+
+		for q := getErrorLineNode(m).Query(`((variable_name) @variable (#eq? @variable "%s"))`, name); q.Next(); {
+			m.Nearest = q.CurrentNode()
+			break
+		}
+
+		// look for a similar name in the current scope (eg. typos)
+		nearestTree := cd.InitOrGetSymbolTree(cd.MainDocumentPath()).GetNearestScopedTree(m.Nearest.StartPosition().Index)
+		nearestDistance := -1
+		for _, sym := range nearestTree.FindSymbolsByClause(func(sym lib.Symbol) bool {
+			return sym.Kind() == lib.SymbolKindAssignment || sym.Kind() == lib.SymbolKindVariable
+		}) {
+			distance := levenshtein.ComputeDistance(name, sym.Name())
+			if distance > 2 || (nearestDistance != -1 && distance >= nearestDistance) {
+				continue
+			}
+
+			ctx.similarName = sym.Name()
+			nearestDistance = distance
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		gen.Add("This error occurs when you use a variable (`$%s`) that has not been assigned a value yet. It may be misspelled or assigned later in the code.", cd.Variables["variable"])
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(undefinedVariableErrorCtx)
+		name := "$" + cd.Variables["variable"]
+
+		if len(ctx.similarName) != 0 {
+			gen.Add("Use the correct variable name", func(s *lib.BugFixSuggestion) {
+				s.AddStep("There is a variable named `%s` which is similar to `%s`. Check if you misspelled the name.", ctx.similarName, name).
+					AddFix(lib.FixSuggestion{
+						NewText:       ctx.similarName,
+						StartPosition: cd.MainError.Nearest.StartPosition(),
+						EndPosition:   cd.MainError.Nearest.EndPosition(),
+					})
+			})
+		}
+
+		gen.Add("Initialize the variable before using it", func(s *lib.BugFixSuggestion) {
+			statement := getStatementNode(cd.MainError.Nearest)
+			startPos := statement.StartPosition()
+			spaces := getSpaceFromBeginning(cd.MainError.Document, startPos.Line)
+
+			s.AddStep("Make sure to assign a value to `%s` before using it.", name).
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("%s = 0;\n%s", name, spaces),
+					StartPosition: startPos,
+					EndPosition:   startPos,
+					Description:   "Replace `0` with the value that you need.",
+				})
+		})
+	},
+}
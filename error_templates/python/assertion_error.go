@@ -0,0 +1,343 @@
+package python
+
+import (
+	"fmt"
+	"math"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/languages/python"
+)
+
+// the message of `assertEqual` in unittest (eg. `-1 != 5` or `1 != 1.5 : wrong average`)
+var unittestEqualMessageRegex = regexp.MustCompile(`^(?P<first>.+?) != (?P<second>.+?)(?: within .+)?(?: : .*)?$`)
+
+// the message of `assertTrue` and `assertFalse` in unittest (eg. `False is not true`)
+var unittestBoolMessageRegex = regexp.MustCompile(`^(?P<value>.+?) is not (?:true|false)(?: : .*)?$`)
+
+type assertionErrorCtx struct {
+	// the `assert` statement or the assert method of unittest (eg. `self.assertEqual(...)`)
+	assertion lib.SyntaxNode
+	// the value given by the code being tested and the value which the test expects
+	actual   lib.SyntaxNode
+	expected lib.SyntaxNode
+	// whether the expected value is written before the actual value in the code
+	swapped       bool
+	operator      string
+	actualValue   string
+	expectedValue string
+	// the call in the actual value (eg. `add(2, 3)`) and the definition of its function
+	call     lib.SyntaxNode
+	function lib.SyntaxNode
+	testName string
+	// the first index where two sequences are different and the actual and
+	// expected items in it, as shown by pytest
+	diffIndex  string
+	diffValues [2]string
+	// whether the values are numbers which are only different because of rounding
+	closeNumbers bool
+}
+
+var AssertionError = lib.ErrorTemplate{
+	Name:    "AssertionError",
+	Pattern: lib.CustomErrorPattern(`(?:FAIL: (?P<testName>\w+) \(\S+\)\n-+\nTraceback \(most recent call last\):\n)?$stacktraceAssertionError(?:: (?P<reason>.*))?`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		aCtx := newAssertionErrorCtx(cd, m, cd.Variables["testName"])
+
+		// the values are only shown by the assert methods of unittest
+		reason := cd.Variables["reason"]
+		if method := assertMethod(aCtx.assertion); strings.HasSuffix(method, "Equal") && !strings.Contains(method, "Not") {
+			if submatches := unittestEqualMessageRegex.FindStringSubmatch(reason); submatches != nil {
+				aCtx.setValues(submatches[1], submatches[2])
+			}
+		} else if method == "assertTrue" || method == "assertFalse" {
+			if submatches := unittestBoolMessageRegex.FindStringSubmatch(reason); submatches != nil {
+				aCtx.actualValue = submatches[1]
+			}
+		}
+
+		aCtx.closeNumbers = areCloseNumbers(aCtx.actualValue, aCtx.expectedValue)
+		m.Context = aCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		explainAssertion(gen, cd.MainError.Context.(assertionErrorCtx))
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(assertionErrorCtx)
+		if ctx.closeNumbers && assertMethod(ctx.assertion) == "assertEqual" {
+			gen.Add("Use assertAlmostEqual instead", func(s *lib.BugFixSuggestion) {
+				method := ctx.assertion.ChildByFieldName("function").ChildByFieldName("attribute")
+				s.AddStep("Compare the numbers with `assertAlmostEqual` which ignores the tiny difference caused by rounding.").
+					AddFix(lib.FixSuggestion{
+						NewText:       "assertAlmostEqual",
+						StartPosition: method.StartPosition(),
+						EndPosition:   method.EndPosition(),
+					})
+			})
+		}
+
+		addAssertionFixes(gen, ctx)
+	},
+}
+
+// newAssertionErrorCtx finds the assertion on the line of the error along with
+// the values it compares and the function which is being tested
+func newAssertionErrorCtx(cd *lib.ContextData, m *lib.MainError, testName string) assertionErrorCtx {
+	aCtx := assertionErrorCtx{testName: testName}
+	if len(aCtx.testName) == 0 && strings.HasPrefix(m.ErrorNode.SymbolName, "test") {
+		aCtx.testName = m.ErrorNode.SymbolName
+	}
+
+	for _, node := range nodesOnErrorLine(m, "assertion", `(assert_statement) @assertion`) {
+		aCtx.assertion = node
+		break
+	}
+
+	if aCtx.assertion.IsNull() {
+		for _, node := range nodesOnErrorLine(m, "assertion", `(call function: (attribute attribute: (identifier) @method (#match? @method "^assert"))) @assertion`) {
+			aCtx.assertion = node
+			break
+		}
+	}
+
+	if aCtx.assertion.IsNull() {
+		return aCtx
+	}
+	m.Nearest = aCtx.assertion
+
+	var first, second lib.SyntaxNode
+	if aCtx.assertion.Type() == "assert_statement" {
+		condition := aCtx.assertion.NamedChild(0)
+		if condition.Type() == "comparison_operator" && condition.NamedChildCount() == 2 {
+			first, second = condition.NamedChild(0), condition.NamedChild(1)
+			aCtx.operator = strings.Join(strings.Fields(condition.Text()[first.EndByte()-condition.StartByte():second.StartByte()-condition.StartByte()]), " ")
+		} else {
+			first = condition
+		}
+	} else {
+		arguments := aCtx.assertion.ChildByFieldName("arguments")
+		switch method := assertMethod(aCtx.assertion); {
+		case strings.HasSuffix(method, "Equal") && !strings.Contains(method, "Not") && arguments.NamedChildCount() >= 2:
+			first, second = arguments.NamedChild(0), arguments.NamedChild(1)
+			aCtx.operator = "=="
+		case method == "assertTrue" && arguments.NamedChildCount() >= 1:
+			first = arguments.NamedChild(0)
+			aCtx.expectedValue = "True"
+		case method == "assertFalse" && arguments.NamedChildCount() >= 1:
+			first = arguments.NamedChild(0)
+			aCtx.expectedValue = "False"
+		}
+	}
+
+	// the side which calls a function is the result of the code being
+	// tested while the other side is the value which the test expects
+	aCtx.actual, aCtx.expected = first, second
+	if !second.IsNull() && !hasCall(first) && hasCall(second) {
+		aCtx.actual, aCtx.expected = second, first
+		aCtx.swapped = true
+	}
+
+	if !aCtx.actual.IsNull() {
+		aCtx.call, aCtx.function = findTestedFunction(cd, m.Document, aCtx.actual)
+	}
+	return aCtx
+}
+
+// setValues sets the values of both sides of the comparison in the order they
+// are written in the code
+func (ctx *assertionErrorCtx) setValues(first string, second string) {
+	if ctx.swapped {
+		first, second = second, first
+	}
+	ctx.actualValue, ctx.expectedValue = first, second
+}
+
+// assertMethod returns the name of the assert method of unittest which is
+// called by the assertion (eg. `assertEqual`)
+func assertMethod(assertion lib.SyntaxNode) string {
+	if assertion.IsNull() || assertion.Type() != "call" {
+		return ""
+	}
+	return assertion.ChildByFieldName("function").ChildByFieldName("attribute").Text()
+}
+
+// hasCall checks if the expression calls a function
+func hasCall(node lib.SyntaxNode) bool {
+	for q := node.Query(`(call) @call`); q.Next(); {
+		return true
+	}
+	return false
+}
+
+// areCloseNumbers checks if both values are decimal numbers which are almost
+// equal (eg. `0.30000000000000004` and `0.3`)
+func areCloseNumbers(a string, b string) bool {
+	if !strings.Contains(a, ".") && !strings.Contains(b, ".") {
+		return false
+	}
+
+	x, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return false
+	}
+
+	y, err := strconv.ParseFloat(b, 64)
+	if err != nil || x == y {
+		return false
+	}
+	return math.Abs(x-y) <= 1e-9*math.Max(1, math.Max(math.Abs(x), math.Abs(y)))
+}
+
+// findTestedFunction returns the first call in the expression to a function
+// which is defined in the project (eg. `add(2, 3)`) along with the definition
+// of the function, which may be in a module imported by the test
+func findTestedFunction(cd *lib.ContextData, doc *lib.Document, expr lib.SyntaxNode) (lib.SyntaxNode, lib.SyntaxNode) {
+	for q := expr.Query(`(call function: [(identifier) (attribute)] @function)`); q.Next(); {
+		function := q.CurrentNode()
+		if definition := findCalledFunction(cd, doc, function); !definition.IsNull() {
+			return function.Parent(), definition
+		}
+	}
+	return lib.SyntaxNode{}, lib.SyntaxNode{}
+}
+
+// findCalledFunction returns the definition of the called function which is
+// either defined in the same file or imported from a module of the project
+// (eg. `from calc import add` or `import calc` for `calc.add`)
+func findCalledFunction(cd *lib.ContextData, doc *lib.Document, function lib.SyntaxNode) lib.SyntaxNode {
+	name, object := function.Text(), ""
+	if function.Type() == "attribute" {
+		name, object = function.ChildByFieldName("attribute").Text(), function.ChildByFieldName("object").Text()
+	} else if definition := findFunction(cd, doc, name, int(function.StartByte())); !definition.IsNull() {
+		return definition
+	}
+
+	module := ""
+	for q := doc.RootNode().Query(`[(import_statement) (import_from_statement)] @import`); q.Next() && len(module) == 0; {
+		node := q.CurrentNode()
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			imported, alias := node.NamedChild(i), ""
+			if imported.Type() == "aliased_import" {
+				imported, alias = imported.ChildByFieldName("name"), imported.ChildByFieldName("alias").Text()
+			}
+
+			if len(alias) == 0 {
+				alias = imported.Text()
+			}
+
+			if node.Type() == "import_statement" && len(object) != 0 && alias == object {
+				module = imported.Text()
+			} else if node.Type() == "import_from_statement" && i != 0 && len(object) == 0 && alias == name {
+				module, name = node.ChildByFieldName("module_name").Text(), imported.Text()
+			}
+		}
+	}
+
+	modulePath := python.ResolveModulePath(cd.FS, filepath.Dir(doc.Path), module)
+	if len(module) == 0 || len(modulePath) == 0 {
+		return lib.SyntaxNode{}
+	}
+
+	tree := parseModule(cd, modulePath)
+	if tree == nil {
+		return lib.SyntaxNode{}
+	}
+
+	sym := tree.Find(name)
+	if sym == nil || sym.Kind() != lib.SymbolKindFunction {
+		return lib.SyntaxNode{}
+	}
+
+	node := cd.Documents[modulePath].RootNode().NamedDescendantForPointRange(sym.Location())
+	for !node.IsNull() && node.Type() != "function_definition" {
+		node = node.Parent()
+	}
+	return node
+}
+
+// explainAssertion explains the values compared by the assertion and points
+// to the function being tested
+func explainAssertion(gen *lib.ExplainGenerator, ctx assertionErrorCtx) {
+	gen.Add("This error occurs when the condition of an assertion is false.")
+	if len(ctx.testName) != 0 {
+		gen.Add(" The test `%s` failed because the code it checks did not give the result which the test expects.", ctx.testName)
+	}
+
+	verb := "is"
+	if !ctx.actual.IsNull() && ctx.actual.Type() == "call" {
+		verb = "returns"
+	}
+
+	switch {
+	case len(ctx.actualValue) != 0 && (ctx.operator == "==" || len(ctx.operator) == 0 && len(ctx.expectedValue) != 0):
+		gen.Add(" `%s` %s `%s`, but the test expects `%s`.", ctx.actual.Text(), verb, ctx.actualValue, ctx.expectedValue)
+	case len(ctx.actualValue) != 0:
+		gen.Add(" `%s` %s `%s`, which makes `%s` false.", ctx.actual.Text(), verb, ctx.actualValue, assertionCondition(ctx.assertion))
+	case !ctx.assertion.IsNull():
+		gen.Add(" The condition `%s` is false.", assertionCondition(ctx.assertion))
+	}
+
+	if len(ctx.diffIndex) != 0 {
+		gen.Add(" The first difference is at index %s, where it has `%s` instead of `%s`.", ctx.diffIndex, ctx.diffValues[0], ctx.diffValues[1])
+	}
+
+	if ctx.closeNumbers {
+		gen.Add(" The numbers are almost the same, but decimal numbers cannot be stored exactly by the computer so they are often off by a tiny amount.")
+	} else if !ctx.function.IsNull() && len(ctx.testName) != 0 {
+		gen.Add(
+			" The test is most likely correct, so look at `%s` in `%s` (line %d), which is the function being tested.",
+			ctx.function.ChildByFieldName("name").Text(), ctx.function.Doc.Path, ctx.function.StartPosition().Line+1,
+		)
+	}
+}
+
+// assertionCondition returns the condition checked by the assertion
+func assertionCondition(assertion lib.SyntaxNode) string {
+	if assertion.Type() == "assert_statement" {
+		return assertion.NamedChild(0).Text()
+	}
+	return assertion.Text()
+}
+
+// addAssertionFixes adds the steps for finding the mistake in the function
+// being tested or in the test itself
+func addAssertionFixes(gen *lib.BugFixGenerator, ctx assertionErrorCtx) {
+	if ctx.closeNumbers {
+		return
+	}
+
+	if !ctx.function.IsNull() {
+		name := ctx.function.ChildByFieldName("name").Text()
+		gen.Add("Fix the function being tested", func(s *lib.BugFixSuggestion) {
+			result := "does not return the result which the test expects"
+			if len(ctx.actualValue) != 0 && len(ctx.expectedValue) != 0 {
+				result = fmt.Sprintf("returns `%s` instead of `%s`", ctx.actualValue, ctx.expectedValue)
+			}
+
+			returns := []lib.SyntaxNode{}
+			for q := ctx.function.Query(`(return_statement (_) @value)`); q.Next(); {
+				returns = append(returns, q.CurrentNode())
+			}
+
+			step := fmt.Sprintf("Go to `%s` in `%s` on line %d and check why `%s` %s", name, ctx.function.Doc.Path, ctx.function.StartPosition().Line+1, ctx.call.Text(), result)
+			if len(returns) == 1 {
+				s.AddStep("%s. It currently returns `%s`.", step, returns[0].Text())
+			} else {
+				s.AddStep("%s.", step)
+			}
+		})
+	}
+
+	if !ctx.expected.IsNull() && ctx.operator == "==" {
+		gen.Add("Check the expected value", func(s *lib.BugFixSuggestion) {
+			s.AddStep("If `%s` is correct, make sure that `%s` is the value which the test should expect.", ctx.actual.Text(), ctx.expected.Text())
+		})
+	} else if ctx.function.IsNull() && !ctx.assertion.IsNull() {
+		gen.Add("Check the condition", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Make sure that `%s` is true at this point of the program, or check the values which it uses.", assertionCondition(ctx.assertion))
+		})
+	}
+}
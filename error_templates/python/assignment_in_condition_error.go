@@ -0,0 +1,51 @@
+package python
+
+import (
+	lib "github.com/nedpals/errgoengine"
+)
+
+type assignmentInConditionErrorCtx struct {
+	operator lib.SyntaxNode
+}
+
+var AssignmentInConditionError = lib.ErrorTemplate{
+	Name:    "AssignmentInConditionError",
+	Pattern: compileTimeError(`SyntaxError: invalid syntax\. Maybe you meant '==' or ':=' instead of '='\?`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		aCtx := assignmentInConditionErrorCtx{}
+		for _, node := range nodesOnErrorLine(m, "error", `(ERROR) @error`) {
+			for i := 0; i < int(node.ChildCount()); i++ {
+				if child := node.Child(i); child.Type() == "=" {
+					aCtx.operator = child
+					m.Nearest = node
+					break
+				}
+			}
+
+			if !aCtx.operator.IsNull() {
+				break
+			}
+		}
+		m.Context = aCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		gen.Add("This error occurs when `=` is used where a value is expected, such as in the condition of an `if` statement or a loop.")
+		gen.Add(" `=` assigns a value to a variable while `==` checks if two values are equal.")
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(assignmentInConditionErrorCtx)
+		if ctx.operator.IsNull() {
+			return
+		}
+
+		gen.Add("Use == to compare the values", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Replace `=` with `==` to check if the values are equal.").
+				AddFix(lib.FixSuggestion{
+					NewText:       "==",
+					StartPosition: ctx.operator.StartPosition(),
+					EndPosition:   ctx.operator.EndPosition(),
+					Description:   "Use `:=` instead if the value should be assigned to a variable and used in the condition at the same time.",
+				})
+		})
+	},
+}
@@ -1,17 +1,290 @@
 package python
 
 import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
 	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/utils/levenshtein"
 )
 
+// attributes of the built-in types which are commonly used
+var builtinAttributes = map[string][]string{
+	"str": {
+		"capitalize", "casefold", "center", "count", "encode", "endswith", "find",
+		"format", "index", "isalnum", "isalpha", "isdecimal", "isdigit", "islower",
+		"isnumeric", "isspace", "istitle", "isupper", "join", "ljust", "lower",
+		"lstrip", "partition", "removeprefix", "removesuffix", "replace", "rfind",
+		"rindex", "rjust", "rsplit", "rstrip", "split", "splitlines", "startswith",
+		"strip", "swapcase", "title", "upper", "zfill",
+	},
+	"list": {"append", "clear", "copy", "count", "extend", "index", "insert", "pop", "remove", "reverse", "sort"},
+	"dict": {"clear", "copy", "fromkeys", "get", "items", "keys", "pop", "popitem", "setdefault", "update", "values"},
+	"set": {
+		"add", "clear", "copy", "difference", "discard", "intersection", "isdisjoint",
+		"issubset", "issuperset", "pop", "remove", "symmetric_difference", "union", "update",
+	},
+	"tuple":    {"count", "index"},
+	"int":      {"bit_length", "conjugate", "denominator", "imag", "numerator", "real", "to_bytes"},
+	"float":    {"as_integer_ratio", "conjugate", "hex", "imag", "is_integer", "real"},
+	"NoneType": {},
+}
+
+// methods from other languages or types which are used in place of the ones
+// of the type. `len` means that the `len()` function is used instead.
+var attributeAlternatives = map[string]map[string]string{
+	"str": {
+		"length": "len", "size": "len", "toUpperCase": "upper", "toLowerCase": "lower",
+		"uppercase": "upper", "lowercase": "lower", "trim": "strip",
+	},
+	"list":  {"length": "len", "size": "len", "add": "append", "push": "append", "indexOf": "index"},
+	"dict":  {"length": "len", "size": "len"},
+	"set":   {"length": "len", "size": "len", "append": "add", "push": "add"},
+	"tuple": {"length": "len", "size": "len"},
+}
+
+type attributeErrorCtx struct {
+	attribute lib.SyntaxNode
+	object    lib.SyntaxNode
+	// the attribute of the type which is named similar to the missing one (eg. `length` for `lenght`)
+	similarAttribute string
+	// the attribute or function used for the same purpose in Python (eg. `append` for `push`)
+	alternative string
+	// the built-in type which has the attribute (eg. `list` for `append`)
+	ownerType  string
+	noneOrigin valueOrigin
+}
+
 var AttributeError = lib.ErrorTemplate{
 	Name:    "AttributeError",
-	Pattern: `AttributeError: '(?P<typeName>\S+)' object has no attribute '(?P<method>\S+)'`,
+	Pattern: `AttributeError: (?:type object )?'(?P<typeName>[^']+)' (?:object )?has no attribute '(?P<attribute>[^']+)'(?:\. Did you mean: '[^']+'\?)?`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		aCtx := attributeErrorCtx{}
+		typeName, attribute := cd.Variables["typeName"], cd.Variables["attribute"]
+		for _, node := range nodesOnErrorLine(m, "attribute", `(attribute attribute: (identifier) @name (#eq? @name "%s")) @attribute`, attribute) {
+			aCtx.attribute = node
+			aCtx.object = node.ChildByFieldName("object")
+			m.Nearest = node
+			break
+		}
+
+		if aCtx.object.IsNull() {
+			// the attribute may be accessed through its name (eg. `getattr(items, "push")`)
+			for _, call := range callsOnErrorLine(m, "getattr") {
+				m.Nearest = call
+				break
+			}
+		}
+
+		if typeName == "NoneType" {
+			if !aCtx.object.IsNull() {
+				aCtx.noneOrigin = findValueOrigin(cd, m.Document, aCtx.object)
+			}
+			m.Context = aCtx
+			return
+		}
+
+		if alternative, ok := attributeAlternatives[typeName][attribute]; ok {
+			aCtx.alternative = alternative
+		} else {
+			aCtx.similarAttribute = similarName(attribute, typeAttributes(cd, m.Document, typeName))
+		}
+
+		if len(aCtx.alternative) == 0 && len(aCtx.similarAttribute) == 0 {
+			types := []string{}
+			for name := range builtinAttributes {
+				types = append(types, name)
+			}
+			sort.Strings(types)
+
+			for _, name := range types {
+				if name != typeName && slices.Contains(builtinAttributes[name], attribute) {
+					aCtx.ownerType = name
+					break
+				}
+			}
+		}
+
+		m.Context = aCtx
+	},
 	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
-		// TODO:
-		gen.Add(`Method "%s" does not exist in "%s" type`, cd.Variables["method"], cd.Variables["typeName"])
+		ctx := cd.MainError.Context.(attributeErrorCtx)
+		typeName, attribute := cd.Variables["typeName"], cd.Variables["attribute"]
+		gen.Add("This error occurs when an attribute or method is used on a value which does not have it.")
+
+		// the value is described through its type when the attribute is not
+		// accessed directly on the error line (eg. `getattr(items, "push")`)
+		if ctx.object.IsNull() {
+			switch {
+			case typeName == "NoneType":
+				gen.Add(" `None` does not have any attributes.")
+			case ctx.alternative == "len":
+				gen.Add(" Values of type `%s` do not have `%s`. The length of a value is found with the `len()` function instead.", typeName, attribute)
+			case len(ctx.alternative) != 0:
+				gen.Add(" Values of type `%s` do not have `%s`. The method which does the same thing in Python is `%s`.", typeName, attribute, ctx.alternative)
+			case len(ctx.similarAttribute) != 0:
+				gen.Add(" Values of type `%s` do not have `%s`, but they have `%s` which has a similar name.", typeName, attribute, ctx.similarAttribute)
+			case len(ctx.ownerType) != 0:
+				gen.Add(" Values of type `%s` do not have `%s`, which is a method of `%s`.", typeName, attribute, ctx.ownerType)
+			default:
+				gen.Add(" Values of type `%s` do not have anything named `%s`.", typeName, attribute)
+			}
+			return
+		}
+
+		value := fmt.Sprintf("`%s`", ctx.object.Text())
+		switch {
+		case typeName == "NoneType":
+			gen.Add(" %s is `None`, which does not have any attributes.", value)
+			explainNoneOrigin(gen, ctx.object, ctx.noneOrigin)
+		case ctx.alternative == "len":
+			gen.Add(" %s is %s which does not have `%s`. The length of a value is found with the `len()` function instead.", value, withArticle(typeName), attribute)
+		case len(ctx.alternative) != 0:
+			gen.Add(" %s is %s which does not have `%s`. The method which does the same thing in Python is `%s`.", value, withArticle(typeName), attribute, ctx.alternative)
+		case len(ctx.similarAttribute) != 0:
+			gen.Add(" %s is %s which does not have `%s`, but it has `%s` which has a similar name.", value, withArticle(typeName), attribute, ctx.similarAttribute)
+		case len(ctx.ownerType) != 0:
+			gen.Add(" %s is %s, but `%s` is a method of `%s`.", value, withArticle(typeName), attribute, ctx.ownerType)
+		default:
+			gen.Add(" %s is %s which does not have anything named `%s`.", value, withArticle(typeName), attribute)
+		}
 	},
 	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
-		// TODO:
+		ctx := cd.MainError.Context.(attributeErrorCtx)
+		typeName, attribute := cd.Variables["typeName"], cd.Variables["attribute"]
+		if ctx.attribute.IsNull() {
+			return
+		}
+
+		doc := cd.MainError.Document
+		name := ctx.attribute.ChildByFieldName("attribute")
+
+		switch {
+		case typeName == "NoneType":
+			addReturnValueFix(doc, gen, ctx.noneOrigin)
+			addNoneFixes(cd, doc, gen, ctx.object)
+		case ctx.alternative == "len":
+			gen.Add("Use len() instead", func(s *lib.BugFixSuggestion) {
+				// `.length()` is replaced along with its parentheses
+				node := ctx.attribute
+				if parent := node.Parent(); parent.Type() == "call" && parent.ChildByFieldName("arguments").NamedChildCount() == 0 {
+					node = parent
+				}
+
+				s.AddStep("Get the length of `%s` with `len()`.", ctx.object.Text()).
+					AddFix(lib.FixSuggestion{
+						NewText:       fmt.Sprintf("len(%s)", ctx.object.Text()),
+						StartPosition: node.StartPosition(),
+						EndPosition:   node.EndPosition(),
+					})
+			})
+		case len(ctx.alternative) != 0 || len(ctx.similarAttribute) != 0:
+			newName := ctx.alternative
+			if len(newName) == 0 {
+				newName = ctx.similarAttribute
+			}
+
+			gen.Add(fmt.Sprintf("Use %s instead", newName), func(s *lib.BugFixSuggestion) {
+				s.AddStep("Replace `%s` with `%s`.", attribute, newName).
+					AddFix(lib.FixSuggestion{
+						NewText:       newName,
+						StartPosition: name.StartPosition(),
+						EndPosition:   name.EndPosition(),
+					})
+			})
+		case len(ctx.ownerType) != 0:
+			call := ctx.attribute.Parent()
+			if typeName == "str" && attribute == "append" && call.Type() == "call" && call.ChildByFieldName("arguments").NamedChildCount() == 1 {
+				gen.Add("Join the strings with +=", func(s *lib.BugFixSuggestion) {
+					argument := call.ChildByFieldName("arguments").NamedChild(0)
+					s.AddStep("Strings cannot be changed, so add `%s` to the end of `%s` with `+=` which creates a new string.", argument.Text(), ctx.object.Text()).
+						AddFix(lib.FixSuggestion{
+							NewText:       fmt.Sprintf(" += %s", argument.Text()),
+							StartPosition: ctx.object.EndPosition(),
+							EndPosition:   call.EndPosition(),
+						})
+				})
+				return
+			}
+
+			gen.Add(fmt.Sprintf("Make %s a %s", ctx.object.Text(), ctx.ownerType), func(s *lib.BugFixSuggestion) {
+				assignment := lib.SyntaxNode{}
+				if ctx.object.Type() == "identifier" {
+					assignment = findAssignment(cd, doc, ctx.object)
+				}
+
+				value := assignment.ChildByFieldName("right")
+				if ctx.ownerType != "list" || value.IsNull() || guessType(cd, doc, value) != typeName {
+					s.AddStep("Make sure that `%s` is %s before `%s` is used.", ctx.object.Text(), withArticle(ctx.ownerType), attribute)
+					return
+				}
+
+				s.AddStep("Put the value of `%s` inside a list so that `%s` can be used.", ctx.object.Text(), attribute).
+					AddFix(lib.FixSuggestion{
+						NewText:       fmt.Sprintf("[%s]", value.Text()),
+						StartPosition: value.StartPosition(),
+						EndPosition:   value.EndPosition(),
+					})
+			})
+		default:
+			gen.Add("Check the attribute name", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Make sure that `%s` is the correct name and that `%s` has the type you expect.", attribute, ctx.object.Text())
+			})
+		}
 	},
 }
+
+// typeAttributes returns the attributes of the built-in type or the class
+// defined in the document. The attributes of a class include its methods,
+// its class variables, and the attributes assigned through `self`.
+func typeAttributes(cd *lib.ContextData, doc *lib.Document, typeName string) []string {
+	if attributes, ok := builtinAttributes[typeName]; ok {
+		return attributes
+	}
+
+	sym := cd.FindSymbol(typeName, -1)
+	class := lib.CastChildrenSymbol(sym)
+	if class == nil || sym.Kind() != lib.SymbolKindClass {
+		return nil
+	}
+
+	attributes := []string{}
+	for name := range class.Children().Symbols {
+		attributes = append(attributes, name)
+	}
+
+	node := doc.RootNode().NamedDescendantForPointRange(sym.Location())
+	for !node.IsNull() && node.Type() != "class_definition" {
+		node = node.Parent()
+	}
+
+	if !node.IsNull() {
+		for q := node.Query(`(assignment left: (attribute object: (identifier) @object attribute: (identifier) @attribute (#eq? @object "self")))`); q.Next(); {
+			if q.CurrentTagName() == "attribute" {
+				attributes = append(attributes, q.CurrentNode().Text())
+			}
+		}
+	}
+
+	sort.Strings(attributes)
+	return attributes
+}
+
+// similarName returns the name which is the closest to the given name or an
+// empty string if none of them are close enough
+func similarName(name string, names []string) string {
+	similar := ""
+	nearestDistance := -1
+	for _, candidate := range names {
+		distance := levenshtein.ComputeDistance(strings.ToLower(name), strings.ToLower(candidate))
+		if candidate == name || distance > 2 || (nearestDistance != -1 && distance >= nearestDistance) {
+			continue
+		}
+
+		similar = candidate
+		nearestDistance = distance
+	}
+	return similar
+}
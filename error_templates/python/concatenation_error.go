@@ -0,0 +1,99 @@
+package python
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type concatenationErrorCtx struct {
+	// the binary operator or augmented assignment (eg. `"Age: " + age`)
+	expr  lib.SyntaxNode
+	left  lib.SyntaxNode
+	right lib.SyntaxNode
+}
+
+var ConcatenationError = lib.ErrorTemplate{
+	Name:    "ConcatenationError",
+	Pattern: `TypeError: can only concatenate (?P<leftType>\S+) \(not "(?P<rightType>\S+)"\) to \S+`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		cCtx := concatenationErrorCtx{}
+		exprs := append(
+			nodesOnErrorLine(m, "expr", `(binary_operator operator: "+") @expr`),
+			nodesOnErrorLine(m, "expr", `(augmented_assignment operator: "+=") @expr`)...,
+		)
+
+		for _, expr := range exprs {
+			left, right := expr.ChildByFieldName("left"), expr.ChildByFieldName("right")
+			if matchesType(cd, m.Document, left, cd.Variables["leftType"]) && matchesType(cd, m.Document, right, cd.Variables["rightType"]) {
+				cCtx.expr, cCtx.left, cCtx.right = expr, left, right
+				m.Nearest = right
+				break
+			}
+		}
+
+		m.Context = cCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(concatenationErrorCtx)
+		leftType, rightType := cd.Variables["leftType"], cd.Variables["rightType"]
+		gen.Add(
+			"This error occurs when `+` is used to join %s with a value of another type. Python does not convert %s automatically, so it cannot be joined with %s.",
+			withArticle(leftType), withArticle(rightType), withArticle(leftType),
+		)
+		if ctx.expr.IsNull() || rightType != "NoneType" {
+			return
+		}
+
+		explainNoneOrigin(gen, ctx.right, findValueOrigin(cd, cd.MainError.Document, ctx.right))
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(concatenationErrorCtx)
+		if ctx.expr.IsNull() {
+			return
+		}
+
+		leftType, rightType := cd.Variables["leftType"], cd.Variables["rightType"]
+		if rightType == "NoneType" {
+			addNoneFixes(cd, cd.MainError.Document, gen, ctx.right)
+			return
+		}
+
+		switch leftType {
+		case "str":
+			gen.Add(fmt.Sprintf("Convert %s to a string", ctx.right.Text()), func(s *lib.BugFixSuggestion) {
+				s.AddStep("Use `str()` to convert `%s` into text before joining it.", ctx.right.Text()).
+					AddFix(lib.FixSuggestion{
+						NewText:       fmt.Sprintf("str(%s)", ctx.right.Text()),
+						StartPosition: ctx.right.StartPosition(),
+						EndPosition:   ctx.right.EndPosition(),
+					})
+			})
+
+			// only simple string literals can be turned into f-strings safely
+			if ctx.expr.Type() != "binary_operator" || ctx.left.Type() != "string" || ctx.left.Text()[0] != '"' || ctx.right.Type() != "identifier" {
+				return
+			}
+
+			gen.Add("Use an f-string", func(s *lib.BugFixSuggestion) {
+				text := ctx.left.Text()
+				s.AddStep("Put `%s` inside an f-string, which converts the value into text automatically.", ctx.right.Text()).
+					AddFix(lib.FixSuggestion{
+						NewText:       fmt.Sprintf("f%s{%s}\"", text[:len(text)-1], ctx.right.Text()),
+						StartPosition: ctx.expr.StartPosition(),
+						EndPosition:   ctx.expr.EndPosition(),
+					})
+			})
+		case "list":
+			gen.Add("Put the value in a list", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Wrap `%s` in square brackets so that a list is joined with another list.", ctx.right.Text()).
+					AddFix(lib.FixSuggestion{
+						NewText:       fmt.Sprintf("[%s]", ctx.right.Text()),
+						StartPosition: ctx.right.StartPosition(),
+						EndPosition:   ctx.right.EndPosition(),
+						Description:   "`append` can also be used to add a single value to the end of the list.",
+					})
+			})
+		}
+	},
+}
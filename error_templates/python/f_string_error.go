@@ -0,0 +1,134 @@
+package python
+
+import (
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type fStringErrorKind int
+
+const (
+	fStringErrorKindUnknown fStringErrorKind = 0
+	// a `{` which is not closed (eg. `f"{name"`)
+	fStringErrorKindUnclosedBrace fStringErrorKind = iota
+	// a `}` which does not close a `{` (eg. `f"{price} }"`)
+	fStringErrorKindSingleBrace fStringErrorKind = iota
+	// braces without an expression between them (eg. `f"{}"`)
+	fStringErrorKindEmptyExpression fStringErrorKind = iota
+)
+
+type fStringErrorCtx struct {
+	kind fStringErrorKind
+	// the brace which causes the error or the expression which the `}` is added after
+	node lib.SyntaxNode
+}
+
+var FStringError = lib.ErrorTemplate{
+	Name:    "FStringError",
+	Pattern: compileTimeError(`SyntaxError: f-string: (?P<reason>.+)`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		fCtx := fStringErrorCtx{}
+		reason := cd.Variables["reason"]
+		switch {
+		case strings.HasPrefix(reason, "expecting '}'"):
+			fCtx.kind = fStringErrorKindUnclosedBrace
+		case strings.HasPrefix(reason, "single '}'"):
+			fCtx.kind = fStringErrorKindSingleBrace
+		case strings.HasPrefix(reason, "empty expression"), strings.HasPrefix(reason, "valid expression required"):
+			fCtx.kind = fStringErrorKindEmptyExpression
+		}
+
+		if fCtx.kind == fStringErrorKindEmptyExpression {
+			// empty braces are parsed as an interpolation with a missing expression
+			for _, node := range nodesOnErrorLine(m, "interpolation", `(interpolation) @interpolation`) {
+				if node.NamedChildCount() == 0 || node.NamedChild(0).IsMissing() {
+					fCtx.node = node
+					m.Nearest = node
+					break
+				}
+			}
+		}
+
+		for _, node := range nodesOnErrorLine(m, "error", `(ERROR) @error`) {
+			if fCtx.kind != fStringErrorKindUnclosedBrace && fCtx.kind != fStringErrorKindSingleBrace {
+				break
+			}
+
+			for i := 0; i < int(node.ChildCount()); i++ {
+				child := node.Child(i)
+				if fCtx.kind == fStringErrorKindUnclosedBrace && child.Type() == "{" && !child.NextSibling().IsNull() {
+					fCtx.node = child.NextSibling()
+					m.Nearest = child.NextSibling()
+					break
+				} else if fCtx.kind == fStringErrorKindSingleBrace && child.Type() == "}" {
+					fCtx.node = child
+					m.Nearest = child
+					break
+				}
+			}
+
+			if !fCtx.node.IsNull() {
+				break
+			}
+		}
+
+		m.Context = fCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(fStringErrorCtx)
+		switch ctx.kind {
+		case fStringErrorKindUnclosedBrace:
+			gen.Add("This error occurs when a `{` in an f-string is not closed with a `}`. Everything between the braces is run as Python code")
+			if !ctx.node.IsNull() {
+				gen.Add(", so `%s` needs a `}` after it.", ctx.node.Text())
+			} else {
+				gen.Add(".")
+			}
+		case fStringErrorKindSingleBrace:
+			gen.Add("This error occurs when an f-string has a `}` which does not close a `{`. To show a brace as text in an f-string, it has to be written twice (`}}`).")
+		case fStringErrorKindEmptyExpression:
+			gen.Add("This error occurs when an f-string has braces with nothing between them. The value to show has to be written between the braces (eg. `{name}`), and braces which are shown as text have to be written twice (`{{}}`).")
+		default:
+			gen.Add("This error occurs when the code inside the braces of an f-string is not written correctly.")
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(fStringErrorCtx)
+		if ctx.node.IsNull() {
+			return
+		}
+
+		switch ctx.kind {
+		case fStringErrorKindUnclosedBrace:
+			gen.Add("Close the curly brace", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Add a `}` after `%s`.", ctx.node.Text()).
+					AddFix(lib.FixSuggestion{
+						NewText:       "}",
+						StartPosition: ctx.node.EndPosition(),
+						EndPosition:   ctx.node.EndPosition(),
+					})
+			})
+		case fStringErrorKindSingleBrace:
+			gen.Add("Escape the curly brace", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Write the `}` twice to show it as text.").
+					AddFix(lib.FixSuggestion{
+						NewText:       "}}",
+						StartPosition: ctx.node.StartPosition(),
+						EndPosition:   ctx.node.EndPosition(),
+						Description:   "Remove it instead if it is not meant to be shown.",
+					})
+			})
+		case fStringErrorKindEmptyExpression:
+			gen.Add("Escape the curly braces", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Write the braces twice to show them as text.").
+					AddFix(lib.FixSuggestion{
+						NewText:       "{{}}",
+						StartPosition: ctx.node.StartPosition(),
+						EndPosition:   ctx.node.EndPosition(),
+						Description:   "Put the value to show between the braces instead if it should be shown.",
+					})
+			})
+		}
+	},
+}
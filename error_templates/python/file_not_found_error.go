@@ -0,0 +1,330 @@
+package python
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/utils/levenshtein"
+)
+
+type fileNotFoundErrorCtx struct {
+	pathNode lib.SyntaxNode
+	// the file in the same folder which is named similar to the missing file (eg. `Data.txt` for `data.txt`)
+	similarFile string
+	// the folder of the script which has the file when the program is run from another folder
+	scriptDir string
+}
+
+var FileNotFoundError = lib.ErrorTemplate{
+	Name:    "FileNotFoundError",
+	Pattern: `FileNotFoundError: \[Errno 2\] No such file or directory: '(?P<path>[^']*)'`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		fCtx := fileNotFoundErrorCtx{}
+		path := cd.Variables["path"]
+		_, fCtx.pathNode = findOpenedPath(m, path)
+		if !fCtx.pathNode.IsNull() {
+			m.Nearest = fCtx.pathNode
+		}
+
+		fCtx.similarFile = similarFileName(cd, path)
+		if len(fCtx.similarFile) == 0 && !filepath.IsAbs(path) {
+			// relative paths are resolved from the folder where the program
+			// is run which may not be the folder of the script
+			scriptDir := filepath.Dir(m.DocumentPath())
+			scriptPath := filepath.Join(scriptDir, path)
+			if _, err := fs.Stat(cd.FS, filepath.ToSlash(scriptPath)); err == nil && scriptPath != resolvePath(cd, path) {
+				fCtx.scriptDir = scriptDir
+			}
+		}
+
+		m.Context = fCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(fileNotFoundErrorCtx)
+		path := cd.Variables["path"]
+		gen.Add("This error occurs when the program tries to open a file which does not exist.")
+
+		if len(ctx.similarFile) != 0 {
+			gen.Add(" There is no file named `%s`, but there is a file named `%s`", path, ctx.similarFile)
+			if strings.EqualFold(ctx.similarFile, path) {
+				gen.Add(". File names are case-sensitive, so the uppercase and lowercase letters have to match.")
+			} else if filepath.Ext(ctx.similarFile) != filepath.Ext(path) {
+				gen.Add(" which has a different extension.")
+			} else {
+				gen.Add(" which has a similar name.")
+			}
+			return
+		}
+
+		if len(ctx.scriptDir) != 0 {
+			gen.Add(
+				" `%s` is in the same folder as the script (`%s`), but relative paths are looked up from the current directory, which is the folder where the program is run from.",
+				path, ctx.scriptDir,
+			)
+		} else if !filepath.IsAbs(path) {
+			gen.Add(
+				" `%s` is a relative path, so Python looks for it in the current directory, which is the folder where the program is run from and not always the folder of the script.",
+				path,
+			)
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(fileNotFoundErrorCtx)
+		path := cd.Variables["path"]
+
+		if len(ctx.similarFile) != 0 && !ctx.pathNode.IsNull() {
+			gen.Add("Use the correct file name", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Open `%s` instead.", ctx.similarFile).
+					AddFix(lib.FixSuggestion{
+						NewText:       replaceStringValue(ctx.pathNode, ctx.similarFile),
+						StartPosition: ctx.pathNode.StartPosition(),
+						EndPosition:   ctx.pathNode.EndPosition(),
+					})
+			})
+			return
+		}
+
+		if len(ctx.scriptDir) != 0 && !ctx.pathNode.IsNull() {
+			addScriptRelativePathFix(cd, gen, ctx.pathNode)
+			return
+		}
+
+		gen.Add("Check the path of the file", func(s *lib.BugFixSuggestion) {
+			if filepath.IsAbs(path) {
+				s.AddStep("Make sure that `%s` exists and that the path is written correctly.", path)
+				return
+			}
+
+			s.AddStep("Make sure that `%s` exists in the folder where the program is run from, or use the full path of the file.", path)
+		})
+	},
+}
+
+// findOpenedPath returns the `open` call on the line of the error along with
+// the string of the path given to it. Strings given to other functions (eg.
+// `pd.read_csv`) are looked up if there is no `open` call.
+func findOpenedPath(m *lib.MainError, path string) (lib.SyntaxNode, lib.SyntaxNode) {
+	for _, call := range callsOnErrorLine(m, "open") {
+		if argument := fileArgument(call); !argument.IsNull() {
+			return call, argument
+		}
+	}
+
+	for _, node := range nodesOnErrorLine(m, "string", `(string) @string`) {
+		if literalValue(node) == path {
+			call := node.Parent()
+			if call.Type() == "argument_list" {
+				call = call.Parent()
+			}
+			return call, node
+		}
+	}
+	return lib.SyntaxNode{}, lib.SyntaxNode{}
+}
+
+// fileArgument returns the argument of the `open` call which has the path
+// of the file, either the first argument or the `file` keyword argument
+func fileArgument(call lib.SyntaxNode) lib.SyntaxNode {
+	arguments := call.ChildByFieldName("arguments")
+	for i := 0; i < int(arguments.NamedChildCount()); i++ {
+		argument := arguments.NamedChild(i)
+		if argument.Type() != "keyword_argument" {
+			return argument
+		} else if argument.ChildByFieldName("name").Text() == "file" {
+			return argument.ChildByFieldName("value")
+		}
+	}
+	return lib.SyntaxNode{}
+}
+
+// keywordArgument returns the value of the keyword argument of the call
+func keywordArgument(call lib.SyntaxNode, name string) lib.SyntaxNode {
+	arguments := call.ChildByFieldName("arguments")
+	for i := 0; i < int(arguments.NamedChildCount()); i++ {
+		if argument := arguments.NamedChild(i); argument.Type() == "keyword_argument" && argument.ChildByFieldName("name").Text() == name {
+			return argument.ChildByFieldName("value")
+		}
+	}
+	return lib.SyntaxNode{}
+}
+
+// resolvePath returns the path of the file relative to the working path
+func resolvePath(cd *lib.ContextData, path string) string {
+	if filepath.IsAbs(path) {
+		return filepath.Clean(path)
+	}
+	return filepath.Join(cd.WorkingPath, path)
+}
+
+// similarFileName returns the path of the file in the same folder whose name
+// only differs by its case, its extension, or a few characters. The folders
+// of the path are kept the way they are written.
+func similarFileName(cd *lib.ContextData, path string) string {
+	if cd.FS == nil {
+		return ""
+	}
+
+	resolvedPath := resolvePath(cd, path)
+	name := filepath.Base(resolvedPath)
+	entries, _ := fs.ReadDir(cd.FS, filepath.ToSlash(filepath.Dir(resolvedPath)))
+
+	similarName := ""
+	nearestDistance := -1
+	for _, entry := range entries {
+		// scripts are not suggested for data files which only share their name
+		if entry.IsDir() || filepath.Ext(entry.Name()) == ".py" && filepath.Ext(name) != ".py" {
+			continue
+		}
+
+		// names which only differ by their case or extension are preferred over typos
+		distance := levenshtein.ComputeDistance(name, entry.Name())
+		switch {
+		case strings.EqualFold(entry.Name(), name):
+			distance = 0
+		case strings.EqualFold(strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name())), strings.TrimSuffix(name, filepath.Ext(name))):
+			distance = 1
+		case distance > 2:
+			continue
+		default:
+			distance++
+		}
+
+		if nearestDistance == -1 || distance < nearestDistance {
+			similarName = entry.Name()
+			nearestDistance = distance
+		}
+	}
+
+	if len(similarName) == 0 {
+		return ""
+	}
+	return path[:len(path)-len(filepath.Base(path))] + similarName
+}
+
+// replaceStringValue returns the string with its value replaced while
+// keeping its prefix and quotes (eg. `r"data.txt"`)
+func replaceStringValue(node lib.SyntaxNode, value string) string {
+	text := node.Text()
+	quoteIdx := strings.IndexAny(text, `"'`)
+	if quoteIdx == -1 {
+		return fmt.Sprintf("%q", value)
+	}
+	return text[:quoteIdx+1] + value + text[quoteIdx:quoteIdx+1]
+}
+
+// addScriptRelativePathFix adds a fix which builds the path from the folder
+// of the script with `os.path.join` and imports `os` if it is not yet
+func addScriptRelativePathFix(cd *lib.ContextData, gen *lib.BugFixGenerator, pathNode lib.SyntaxNode) {
+	doc := cd.MainError.Document
+	osPath, imported := importedOsPath(doc)
+
+	gen.Add("Open the file relative to the script", func(s *lib.BugFixSuggestion) {
+		s.AddStep("Build the path from the folder of the script so that the file is found wherever the program is run from.").
+			AddFix(lib.FixSuggestion{
+				NewText:       fmt.Sprintf("%s.join(%s.dirname(__file__), %s)", osPath, osPath, pathNode.Text()),
+				StartPosition: pathNode.StartPosition(),
+				EndPosition:   pathNode.EndPosition(),
+			})
+
+		if imported {
+			return
+		}
+
+		fix := lib.FixSuggestion{NewText: "import os\n"}
+		if line := lastHeaderLine(doc); line != -1 {
+			// the import is put on a new line after the end of the header
+			fix = lib.FixSuggestion{
+				NewText:       "\nimport os",
+				StartPosition: lib.Position{Line: line, Column: len(doc.LineAt(line))},
+				EndPosition:   lib.Position{Line: line, Column: len(doc.LineAt(line))},
+			}
+		}
+		s.AddStep("Import the `os` module at the top of the file.").AddFix(fix)
+	})
+}
+
+// importedOsPath returns the name which `os.path` is accessed with in the
+// document (eg. `path` for `from os import path`) and whether it is imported.
+// `os.path` is returned if it is not imported yet.
+func importedOsPath(doc *lib.Document) (string, bool) {
+	for q := doc.RootNode().Query(`[(import_statement) (import_from_statement)] @import`); q.Next(); {
+		node := q.CurrentNode()
+		isFromImport := node.Type() == "import_from_statement"
+		if isFromImport && node.ChildByFieldName("module_name").Text() != "os" {
+			continue
+		}
+
+		// the names after the module of `from os import ...` are the imported ones
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			name := node.NamedChild(i)
+			if isFromImport && i == 0 {
+				continue
+			}
+
+			alias := lib.SyntaxNode{}
+			if name.Type() == "aliased_import" {
+				alias = name.ChildByFieldName("alias")
+				name = name.ChildByFieldName("name")
+			}
+
+			switch {
+			case isFromImport && name.Text() == "path":
+				if !alias.IsNull() {
+					return alias.Text(), true
+				}
+				return "path", true
+			case isFromImport:
+				continue
+			case name.Text() == "os" || name.Text() == "os.path":
+				if alias.IsNull() {
+					return "os.path", true
+				} else if name.Text() == "os.path" {
+					return alias.Text(), true
+				}
+				return alias.Text() + ".path", true
+			case strings.HasPrefix(name.Text(), "os."):
+				// `import os.something` makes `os` available as well
+				return "os.path", true
+			}
+		}
+	}
+	return "os.path", false
+}
+
+// lastHeaderLine returns the last line of the shebang, the docstring, and the
+// `__future__` imports at the top of the module which have to stay before the
+// other imports, or -1 if the module does not start with them
+func lastHeaderLine(doc *lib.Document) int {
+	line := -1
+	root := doc.RootNode()
+	isFirstStatement := true
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		node := root.NamedChild(i)
+		switch node.Type() {
+		case "comment":
+			// only the shebang and the encoding declaration are kept above the imports
+			if !strings.HasPrefix(node.Text(), "#!") && !strings.Contains(node.Text(), "coding") {
+				return line
+			}
+		case "expression_statement":
+			if !isFirstStatement || node.NamedChildCount() != 1 || node.FirstNamedChild().Type() != "string" {
+				return line
+			}
+		case "import_from_statement":
+			if node.ChildByFieldName("module_name").Text() != "__future__" {
+				return line
+			}
+		default:
+			return line
+		}
+
+		if node.Type() != "comment" {
+			isFirstStatement = false
+		}
+		line = node.EndPosition().Line
+	}
+	return line
+}
@@ -0,0 +1,79 @@
+package python
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type globalDeclarationErrorCtx struct {
+	declaration lib.SyntaxNode
+	function    lib.SyntaxNode
+	// the first usage of the variable in the function before the declaration
+	usage lib.SyntaxNode
+}
+
+var GlobalDeclarationError = lib.ErrorTemplate{
+	Name:    "GlobalDeclarationError",
+	Pattern: compileTimeError(`SyntaxError: name '(?P<variable>\w+)' is (?:used|assigned to) prior to (?P<keyword>global|nonlocal) declaration`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		gCtx := globalDeclarationErrorCtx{}
+		variable := cd.Variables["variable"]
+		for _, node := range nodesOnErrorLine(m, "declaration", `[(global_statement) (nonlocal_statement)] @declaration`) {
+			gCtx.declaration = node
+			m.Nearest = node
+			break
+		}
+
+		if gCtx.declaration.IsNull() {
+			m.Context = gCtx
+			return
+		}
+
+		gCtx.function = enclosingScope(gCtx.declaration)
+		for q := gCtx.function.Query(`((identifier) @name (#eq? @name "%s"))`, variable); q.Next(); {
+			if node := q.CurrentNode(); node.StartByte() < gCtx.declaration.StartByte() && enclosingScope(node).StartByte() == gCtx.function.StartByte() {
+				gCtx.usage = node
+				break
+			}
+		}
+
+		m.Context = gCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(globalDeclarationErrorCtx)
+		gen.Add("This error occurs when a variable is used in a function before the `%s` statement which declares it.", cd.Variables["keyword"])
+		if ctx.usage.IsNull() {
+			return
+		}
+
+		gen.Add(
+			" `%s` is used on line %d but `%s` is only on line %d. The declaration applies to the whole function, so it has to come before any use of the variable.",
+			cd.Variables["variable"], ctx.usage.StartPosition().Line+1, ctx.declaration.Text(), ctx.declaration.StartPosition().Line+1,
+		)
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(globalDeclarationErrorCtx)
+		if ctx.declaration.IsNull() || ctx.function.Type() != "function_definition" {
+			return
+		}
+
+		gen.Add("Move the declaration to the start of the function", func(s *lib.BugFixSuggestion) {
+			body := ctx.function.ChildByFieldName("body")
+			spaces := cd.MainError.Document.LineAt(body.StartPosition().Line)[:body.StartPosition().Column]
+			line := ctx.declaration.StartPosition().Line
+
+			s.AddStep("Move `%s` to the start of `%s`, before `%s` is used.", ctx.declaration.Text(), ctx.function.ChildByFieldName("name").Text(), cd.Variables["variable"]).
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("%s%s\n", spaces, ctx.declaration.Text()),
+					StartPosition: lib.Position{Line: body.StartPosition().Line},
+					EndPosition:   lib.Position{Line: body.StartPosition().Line},
+				}).
+				AddFix(lib.FixSuggestion{
+					NewText:       "",
+					StartPosition: lib.Position{Line: line},
+					EndPosition:   lib.Position{Line: line, Column: len(cd.MainError.Document.LineAt(line))},
+				})
+		})
+	},
+}
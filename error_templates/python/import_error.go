@@ -0,0 +1,207 @@
+package python
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/languages/python"
+	"github.com/nedpals/errgoengine/utils/levenshtein"
+)
+
+type importErrorKind int
+
+const (
+	importErrorKindUnknown importErrorKind = 0
+	// a file in the project has the same name as a module of the standard library
+	importErrorKindShadowing   importErrorKind = iota
+	importErrorKindCircular    importErrorKind = iota
+	importErrorKindMissingName importErrorKind = iota
+)
+
+type importErrorCtx struct {
+	kind       importErrorKind
+	importNode lib.SyntaxNode
+	// the node of the name which cannot be imported
+	nameNode lib.SyntaxNode
+	// the path of the file of the module in the project, if any
+	modulePath string
+	// the files which import each other (eg. `a.py`, `b.py`, `a.py`)
+	cycle []string
+	// the name in the module which is similar to the name which cannot be imported
+	similarName string
+}
+
+var ImportError = lib.ErrorTemplate{
+	Name:    "ImportError",
+	Pattern: `ImportError: cannot import name '(?P<name>[^']+)' from (?:partially initialized module )?'(?P<module>[^']+)'(?: \(most likely due to a circular import\))? \((?P<location>[^)]+)\)`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		iCtx := importErrorCtx{}
+		module, name := cd.Variables["module"], cd.Variables["name"]
+		iCtx.importNode, _ = findImport(m, module)
+		if !iCtx.importNode.IsNull() {
+			m.Nearest = iCtx.importNode
+			for q := iCtx.importNode.Query(`((dotted_name) @name (#eq? @name "%s"))`, name); q.Next(); {
+				iCtx.nameNode = q.CurrentNode()
+				m.Nearest = iCtx.nameNode
+			}
+		}
+
+		dir := filepath.Dir(m.DocumentPath())
+		iCtx.modulePath = python.ResolveModulePath(cd.FS, dir, module)
+		if len(iCtx.modulePath) == 0 && strings.HasSuffix(cd.Variables["location"], "/"+module+".py") && filepath.Base(m.DocumentPath()) == module+".py" {
+			// the file which imports the module is the module itself
+			iCtx.modulePath = m.DocumentPath()
+		}
+
+		switch {
+		case isStdlibModule(module) && len(iCtx.modulePath) != 0:
+			iCtx.kind = importErrorKindShadowing
+		case len(iCtx.modulePath) != 0 && len(cd.DepGraph.Cycle(m.DocumentPath())) != 0:
+			iCtx.kind = importErrorKindCircular
+			iCtx.cycle = cd.DepGraph.Cycle(m.DocumentPath())
+		case len(iCtx.modulePath) != 0:
+			iCtx.kind = importErrorKindMissingName
+			iCtx.similarName = similarModuleName(cd, iCtx.modulePath, name)
+		}
+
+		m.Context = iCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(importErrorCtx)
+		module, name := cd.Variables["module"], cd.Variables["name"]
+		switch ctx.kind {
+		case importErrorKindShadowing:
+			gen.Add("This error occurs when a file in your project has the same name as a module of Python's standard library.")
+			gen.Add(" Python imports `%s` instead of the `%s` module that comes with Python, and `%s` is not in it.", ctx.modulePath, module, name)
+		case importErrorKindCircular:
+			gen.Add("This error occurs when two or more modules import each other (also known as a circular import).")
+			chain := make([]string, len(ctx.cycle))
+			for i, path := range ctx.cycle {
+				chain[i] = fmt.Sprintf("`%s`", path)
+			}
+			gen.Add(
+				" %s import each other in a loop, so `%s` is imported while it is still being loaded and `%s` is not defined in it yet.",
+				strings.Join(chain, " → "), ctx.modulePath, name,
+			)
+		default:
+			gen.Add("This error occurs when a name is imported from a module which does not have it.")
+			gen.Add(" `%s` does not have anything named `%s`.", module, name)
+			if len(ctx.similarName) != 0 {
+				gen.Add(" It has `%s` which has a similar name.", ctx.similarName)
+			}
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(importErrorCtx)
+		module, name := cd.Variables["module"], cd.Variables["name"]
+		switch ctx.kind {
+		case importErrorKindShadowing:
+			gen.Add("Rename the file", func(s *lib.BugFixSuggestion) {
+				s.AddStep(
+					"Rename `%s` to a name which is not used by Python's modules, such as `my_%s`. Delete the `__pycache__` folder next to it as well if there is one.",
+					ctx.modulePath, filepath.Base(ctx.modulePath),
+				)
+			})
+		case importErrorKindCircular:
+			if ctx.importNode.IsNull() || ctx.importNode.Type() != "import_from_statement" {
+				return
+			}
+
+			gen.Add("Import the module instead", func(s *lib.BugFixSuggestion) {
+				// `from` is replaced separately from the imported names since
+				// replacing the whole line removes it from the document
+				moduleName := ctx.importNode.ChildByFieldName("module_name")
+				s.AddStep("Import the whole `%s` module so that `%s` is only looked up when it is used.", module, name).
+					AddFix(lib.FixSuggestion{
+						NewText:       "import",
+						StartPosition: ctx.importNode.Child(0).StartPosition(),
+						EndPosition:   ctx.importNode.Child(0).EndPosition(),
+					}).
+					AddFix(lib.FixSuggestion{
+						NewText:       "",
+						StartPosition: moduleName.EndPosition(),
+						EndPosition:   ctx.importNode.EndPosition(),
+					})
+
+				replaceUsages(s, cd.MainError.Document, ctx.importNode, name, fmt.Sprintf("%s.%s", module, name))
+			})
+		case importErrorKindMissingName:
+			if len(ctx.similarName) == 0 || ctx.nameNode.IsNull() {
+				return
+			}
+
+			gen.Add("Fix the imported name", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Import `%s` from `%s` instead.", ctx.similarName, module).
+					AddFix(lib.FixSuggestion{
+						NewText:       ctx.similarName,
+						StartPosition: ctx.nameNode.StartPosition(),
+						EndPosition:   ctx.nameNode.EndPosition(),
+					})
+
+				replaceUsages(s, cd.MainError.Document, ctx.importNode, name, ctx.similarName)
+			})
+		}
+	},
+}
+
+// replaceUsages adds the steps which replace the usages of the imported name
+// outside of the import statement. The changes on each line are shown in
+// separate steps.
+func replaceUsages(s *lib.BugFixSuggestion, doc *lib.Document, importNode lib.SyntaxNode, name string, newText string) {
+	var step *lib.BugFixStep
+	lastLine := -1
+	for q := doc.RootNode().Query(`((identifier) @name (#eq? @name "%s"))`, name); q.Next(); {
+		node := q.CurrentNode()
+		if node.StartByte() >= importNode.StartByte() && node.EndByte() <= importNode.EndByte() {
+			continue
+		}
+
+		if line := node.StartPosition().Line; line != lastLine {
+			step = s.AddStep("Use `%s` on line %d as well.", newText, line+1)
+			lastLine = line
+		}
+
+		step.AddFix(lib.FixSuggestion{
+			NewText:       newText,
+			StartPosition: node.StartPosition(),
+			EndPosition:   node.EndPosition(),
+		})
+	}
+}
+
+// parseModule parses the module file if it is not yet and returns its symbols
+func parseModule(cd *lib.ContextData, modulePath string) *lib.SymbolTree {
+	if _, ok := cd.Documents[modulePath]; !ok {
+		if err := lib.ParseFiles(cd, python.Language, cd.FS, []string{modulePath}); err != nil {
+			return nil
+		}
+	}
+	return cd.Symbols[modulePath]
+}
+
+// similarModuleName returns the name defined in the module file which is
+// similar to the given name. The file is parsed if it is not yet.
+func similarModuleName(cd *lib.ContextData, modulePath string, name string) string {
+	tree := parseModule(cd, modulePath)
+	if tree == nil {
+		return ""
+	}
+
+	similarName := ""
+	nearestDistance := -1
+	for _, sym := range tree.FindSymbolsByClause(func(sym lib.Symbol) bool {
+		return sym.Kind() == lib.SymbolKindAssignment || sym.Kind() == lib.SymbolKindFunction || sym.Kind() == lib.SymbolKindClass
+	}) {
+		distance := levenshtein.ComputeDistance(strings.ToLower(name), strings.ToLower(sym.Name()))
+		// symbols are not ordered so names with the same distance are compared as well
+		if distance > 2 || (nearestDistance != -1 && (distance > nearestDistance || distance == nearestDistance && sym.Name() > similarName)) {
+			continue
+		}
+
+		similarName = sym.Name()
+		nearestDistance = distance
+	}
+	return similarName
+}
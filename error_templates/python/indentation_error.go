@@ -1,42 +1,298 @@
 package python
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	lib "github.com/nedpals/errgoengine"
 )
 
+type indentationErrorKind int
+
+const (
+	indentationErrorKindUnknown indentationErrorKind = 0
+	// a line is unindented to a level which does not match any of the blocks before it
+	indentationErrorKindUnindent indentationErrorKind = iota
+	// a line is indented more than the line before it without starting a block
+	indentationErrorKindUnexpectedIndent indentationErrorKind = iota
+	// the line after a statement which starts a block (eg. `if`) is not indented
+	indentationErrorKindExpectedIndent indentationErrorKind = iota
+)
+
+type indentationErrorCtx struct {
+	kind indentationErrorKind
+	line int
+	// the line which the indentation is compared to (eg. the `if` statement of the block)
+	otherLine int
+	// the indentation that the line should have
+	indentation string
+	// the indentation levels of the blocks before the line, from the innermost one
+	levels []string
+	// whether the block has no lines in it so a `pass` is added instead
+	emptyBlock bool
+}
+
 var IndentationError = lib.ErrorTemplate{
 	Name:    "IndentationError",
-	Pattern: compileTimeError("IndentationError: unindent does not match any outer indentation level"),
+	Pattern: compileTimeError(`IndentationError: (?P<reason>unindent does not match any outer indentation level|unexpected indent|expected an indented block(?: after (?P<statement>.+) on line (?P<blockLine>\d+))?)`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		iCtx := indentationErrorCtx{line: m.ErrorNode.StartPos.Line - 1}
+		doc := m.Document
+		iCtx.otherLine = previousCodeLine(doc, iCtx.line)
+		reason := cd.Variables["reason"]
+
+		switch {
+		case strings.HasPrefix(reason, "unindent"):
+			iCtx.kind = indentationErrorKindUnindent
+			iCtx.levels = indentationLevels(doc, iCtx.line)
+
+			// the nearest level is used and the inner one is preferred when
+			// the line is between two levels
+			width := indentationWidth(leadingWhitespace(doc.LineAt(iCtx.line)))
+			nearestDistance := -1
+			for _, level := range iCtx.levels {
+				distance := indentationWidth(level) - width
+				if distance < 0 {
+					distance = -distance
+				}
+
+				if nearestDistance == -1 || distance < nearestDistance {
+					iCtx.indentation = level
+					nearestDistance = distance
+				}
+			}
+		case strings.HasPrefix(reason, "unexpected indent"):
+			iCtx.kind = indentationErrorKindUnexpectedIndent
+			if iCtx.otherLine != -1 {
+				iCtx.indentation = leadingWhitespace(doc.LineAt(iCtx.otherLine))
+			}
+		default:
+			iCtx.kind = indentationErrorKindExpectedIndent
+			if blockLine, err := strconv.Atoi(cd.Variables["blockLine"]); err == nil {
+				iCtx.otherLine = blockLine - 1
+			}
+
+			if iCtx.otherLine != -1 {
+				blockIndentation := leadingWhitespace(doc.LineAt(iCtx.otherLine))
+				iCtx.indentation = blockIndentation + indentationUnit(doc)
+				iCtx.emptyBlock = iCtx.line >= doc.TotalLines() ||
+					indentationWidth(leadingWhitespace(doc.LineAt(iCtx.line))) < indentationWidth(blockIndentation)
+			}
+		}
+
+		m.Context = iCtx
+	},
 	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
-		gen.Add("This error occurs when there is a mismatch in the indentation levels in the code.")
+		ctx := cd.MainError.Context.(indentationErrorCtx)
+		doc := cd.MainError.Document
+		indentation := leadingWhitespace(doc.LineAt(ctx.line))
+
+		switch ctx.kind {
+		case indentationErrorKindUnindent:
+			gen.Add("This error occurs when a line is unindented to a level which does not match any of the blocks before it.")
+			levels := make([]string, len(ctx.levels))
+			for i, level := range ctx.levels {
+				levels[i] = describeIndentation(level)
+			}
+			gen.Add(" Line %d starts with %s, but the blocks before it are indented with %s.", ctx.line+1, describeIndentation(indentation), strings.Join(levels, " or "))
+		case indentationErrorKindUnexpectedIndent:
+			gen.Add("This error occurs when a line is indented more than the line before it even though it is not the start of a new block.")
+			if ctx.otherLine != -1 {
+				gen.Add(
+					" Line %d starts with %s while line %d starts with %s.",
+					ctx.line+1, describeIndentation(indentation), ctx.otherLine+1, describeIndentation(ctx.indentation),
+				)
+			}
+		case indentationErrorKindExpectedIndent:
+			gen.Add("This error occurs when the lines of a block are not indented after the statement which starts it. Python uses the indentation to know which lines are inside the block.")
+			if ctx.otherLine != -1 {
+				statement := strings.ReplaceAll(cd.Variables["statement"], "'", "`")
+				if len(statement) == 0 {
+					statement = "statement"
+				}
+				gen.Add(" The %s on line %d has to be followed by at least one line which is indented more than it.", statement, ctx.otherLine+1)
+			}
+		default:
+			gen.Add("This error occurs when there is a mismatch in the indentation levels in the code.")
+			return
+		}
+
+		if ctx.otherLine != -1 && hasMixedIndentation(indentation, leadingWhitespace(doc.LineAt(ctx.otherLine))) {
+			gen.Add(" The indentation mixes tabs and spaces, which look the same in most editors but are counted differently by Python.")
+		}
+
+		if ctx.kind != indentationErrorKindExpectedIndent {
+			gen.Add(whitespaceLegend)
+		}
 	},
 	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
-		gen.Add("Correct the indentation", func(s *lib.BugFixSuggestion) {
-			// use the previous sibling's spacing as basis
-			prevSibling := cd.MainError.Nearest.PrevNamedSibling()
-			if prevSibling.Type() == "function_definition" {
-				prevSibling = prevSibling.ChildByFieldName("body").LastNamedChild()
-			}
-
-			spaces := cd.MainError.Document.LineAt(prevSibling.StartPosition().Line)[:prevSibling.StartPosition().Column]
-
-			s.AddStep("Ensure consistent indentation by using the correct spacing for each level of indentation.").
-				AddFix(lib.FixSuggestion{
-					NewText: "",
-					StartPosition: lib.Position{
-						Line: cd.MainError.Nearest.StartPosition().Line,
-					},
-					EndPosition: cd.MainError.Nearest.StartPosition(),
-				}).
-				AddFix(lib.FixSuggestion{
-					NewText: spaces,
-					StartPosition: lib.Position{
-						Line: cd.MainError.Nearest.StartPosition().Line,
-					},
-					EndPosition: lib.Position{
-						Line: cd.MainError.Nearest.StartPosition().Line,
-					},
+		ctx := cd.MainError.Context.(indentationErrorCtx)
+		doc := cd.MainError.Document
+
+		switch ctx.kind {
+		case indentationErrorKindUnindent, indentationErrorKindUnexpectedIndent:
+			gen.Add("Correct the indentation", func(s *lib.BugFixSuggestion) {
+				// the lines after it which have the same indentation are
+				// moved along with it so that the block stays together
+				indentation := leadingWhitespace(doc.LineAt(ctx.line))
+				lastLine := ctx.line
+				for i := ctx.line + 1; i < doc.TotalLines(); i++ {
+					if line := doc.LineAt(i); len(strings.TrimSpace(line)) != 0 {
+						if !strings.HasPrefix(line, indentation) || (ctx.kind == indentationErrorKindUnindent && leadingWhitespace(line) != indentation) {
+							break
+						}
+						lastLine = i
+					}
+				}
+
+				var step *lib.BugFixStep
+				if lastLine != ctx.line {
+					step = s.AddStep("Indent lines %d to %d with %s to match the block they belong to.", ctx.line+1, lastLine+1, describeIndentation(ctx.indentation))
+				} else {
+					step = s.AddStep("Indent line %d with %s to match the block it belongs to.", ctx.line+1, describeIndentation(ctx.indentation))
+				}
+				reindentLines(doc, step, ctx.line, lastLine, indentation, ctx.indentation)
+			})
+		case indentationErrorKindExpectedIndent:
+			if ctx.otherLine == -1 {
+				return
+			}
+
+			if ctx.emptyBlock {
+				gen.Add("Add a pass statement", func(s *lib.BugFixSuggestion) {
+					blockLine := doc.LineAt(ctx.otherLine)
+					s.AddStep("Add `pass` inside the block if it should not do anything yet.").
+						AddFix(lib.FixSuggestion{
+							NewText:       "\n" + ctx.indentation + "pass",
+							StartPosition: lib.Position{Line: ctx.otherLine, Column: len(blockLine)},
+							EndPosition:   lib.Position{Line: ctx.otherLine, Column: len(blockLine)},
+						})
 				})
-		})
+				return
+			}
+
+			gen.Add("Indent the block", func(s *lib.BugFixSuggestion) {
+				step := s.AddStep("Indent line %d so that it is inside the block which starts on line %d.", ctx.line+1, ctx.otherLine+1)
+				reindentLines(doc, step, ctx.line, ctx.line, leadingWhitespace(doc.LineAt(ctx.line)), ctx.indentation)
+				step.Fixes[0].Description = "Indent the lines after it as well if they are also part of the block."
+			})
+		}
 	},
 }
+
+// shown after the indentation is described with visible characters
+const whitespaceLegend = " Here `·` stands for a space and `→` stands for a tab."
+
+// leadingWhitespace returns the spaces and tabs at the start of the line
+func leadingWhitespace(line string) string {
+	return line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+}
+
+// indentationWidth returns the number of columns of the indentation where
+// each tab moves to the next multiple of 8 like how Python counts them
+func indentationWidth(indentation string) int {
+	width := 0
+	for _, char := range indentation {
+		if char == '\t' {
+			width += 8 - width%8
+		} else {
+			width++
+		}
+	}
+	return width
+}
+
+// indentationUnit returns the indentation used for one level in the document
+// (eg. four spaces) based on the first indented line
+func indentationUnit(doc *lib.Document) string {
+	for _, line := range doc.Lines() {
+		if indentation := leadingWhitespace(line); len(indentation) != 0 && len(strings.TrimSpace(line)) != 0 {
+			if indentation[0] == '\t' {
+				return "\t"
+			}
+			return indentation
+		}
+	}
+	return "    "
+}
+
+// indentationLevels returns the indentation of the blocks which the line
+// may belong to, starting from the line before it up to the top level
+func indentationLevels(doc *lib.Document, line int) []string {
+	levels := []string{}
+	minWidth := -1
+	for i := previousCodeLine(doc, line); i >= 0; i = previousCodeLine(doc, i) {
+		indentation := leadingWhitespace(doc.LineAt(i))
+		if width := indentationWidth(indentation); minWidth == -1 || width < minWidth {
+			levels = append(levels, indentation)
+			minWidth = width
+		}
+
+		if minWidth == 0 {
+			break
+		}
+	}
+	return levels
+}
+
+// previousCodeLine returns the index of the nearest line before the line
+// which is not empty or a comment, or -1 if there is none
+func previousCodeLine(doc *lib.Document, line int) int {
+	for i := min(line, doc.TotalLines()) - 1; i >= 0; i-- {
+		if text := strings.TrimSpace(doc.LineAt(i)); len(text) != 0 && !strings.HasPrefix(text, "#") {
+			return i
+		}
+	}
+	return -1
+}
+
+// visibleWhitespace shows the spaces and tabs of the indentation as `·` and `→`
+func visibleWhitespace(indentation string) string {
+	return strings.NewReplacer(" ", "·", "\t", "→").Replace(indentation)
+}
+
+// describeIndentation returns the indentation with visible whitespace along
+// with the number of spaces and tabs in it (eg. "`····` (4 spaces)")
+func describeIndentation(indentation string) string {
+	if len(indentation) == 0 {
+		return "no indentation"
+	}
+
+	counts := []string{}
+	if tabs := strings.Count(indentation, "\t"); tabs == 1 {
+		counts = append(counts, "1 tab")
+	} else if tabs > 1 {
+		counts = append(counts, fmt.Sprintf("%d tabs", tabs))
+	}
+
+	if spaces := strings.Count(indentation, " "); spaces == 1 {
+		counts = append(counts, "1 space")
+	} else if spaces > 1 {
+		counts = append(counts, fmt.Sprintf("%d spaces", spaces))
+	}
+	return fmt.Sprintf("`%s` (%s)", visibleWhitespace(indentation), strings.Join(counts, " and "))
+}
+
+// hasMixedIndentation checks if one of the indentations uses tabs while the
+// other one uses spaces
+func hasMixedIndentation(a string, b string) bool {
+	return strings.Contains(a, "\t") && strings.Contains(b, " ") || strings.Contains(a, " ") && strings.Contains(b, "\t")
+}
+
+// reindentLines replaces the indentation at the start of the lines, keeping
+// the extra indentation of the nested blocks in them. Empty lines are skipped.
+func reindentLines(doc *lib.Document, step *lib.BugFixStep, from int, to int, oldIndentation string, newIndentation string) {
+	for i := from; i <= to; i++ {
+		line := doc.LineAt(i)
+		if len(strings.TrimSpace(line)) == 0 || !strings.HasPrefix(line, oldIndentation) {
+			continue
+		}
+
+		step.AddFix(lib.FixSuggestion{
+			NewText:       newIndentation,
+			StartPosition: lib.Position{Line: i},
+			EndPosition:   lib.Position{Line: i, Column: len(oldIndentation)},
+		})
+	}
+}
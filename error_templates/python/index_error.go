@@ -0,0 +1,217 @@
+package python
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+// the types of the containers as shown in the error message
+var indexErrorContainerTypes = map[string]string{
+	"list":   "list",
+	"string": "str",
+	"tuple":  "tuple",
+}
+
+type indexErrorCtx struct {
+	subscript lib.SyntaxNode
+	container lib.SyntaxNode
+	index     lib.SyntaxNode
+	// the number of items of the container if it is given a literal, -1 otherwise
+	length int
+	// the stop argument of the `range()` in the loop which gives the index
+	// (eg. `len(items) + 1` in `for i in range(len(items) + 1)`)
+	rangeStop lib.SyntaxNode
+	// the `len()` call which the range goes past
+	lengthCall lib.SyntaxNode
+}
+
+var IndexError = lib.ErrorTemplate{
+	Name:    "IndexError",
+	Pattern: `IndexError: (?P<container>\S+) (?:assignment )?index out of range`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		iCtx := indexErrorCtx{length: -1}
+		containerType := indexErrorContainerTypes[cd.Variables["container"]]
+		for _, subscript := range nodesOnErrorLine(m, "subscript", `(subscript) @subscript`) {
+			container, index := subscript.ChildByFieldName("value"), subscript.ChildByFieldName("subscript")
+			if index.Type() != "slice" && matchesType(cd, m.Document, container, containerType) {
+				iCtx.subscript, iCtx.container, iCtx.index = subscript, container, index
+				m.Nearest = subscript
+				break
+			}
+		}
+
+		if iCtx.subscript.IsNull() {
+			m.Context = iCtx
+			return
+		}
+
+		iCtx.length = literalLength(findValueOrigin(cd, m.Document, iCtx.container).value)
+		if iCtx.index.Type() == "identifier" {
+			iCtx.rangeStop, iCtx.lengthCall = findRangeOverLength(iCtx.subscript, iCtx.index.Text(), iCtx.container.Text())
+		}
+
+		m.Context = iCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(indexErrorCtx)
+		container := cd.Variables["container"]
+		gen.Add("This error occurs when an item of a %s is accessed with an index that is not in the %s. Indexes start at 0, so the last item is at the index one less than the length of the %s.", container, container, container)
+		if ctx.subscript.IsNull() {
+			return
+		}
+
+		if ctx.length == 0 {
+			gen.Add(" `%s` is empty, so it does not have any %s to access.", ctx.container.Text(), itemNoun(container, 0))
+		} else if ctx.length > 0 {
+			if ctx.length == 1 {
+				gen.Add(" `%s` only has 1 %s, so its only index is 0", ctx.container.Text(), itemNoun(container, 1))
+			} else {
+				gen.Add(" `%s` has %d %s, so its indexes go from 0 to %d", ctx.container.Text(), ctx.length, itemNoun(container, ctx.length), ctx.length-1)
+			}
+
+			if value, ok := integerValue(ctx.index); ok {
+				gen.Add(", but index %d is used.", value)
+			} else {
+				gen.Add(".")
+			}
+		}
+
+		if !ctx.rangeStop.IsNull() {
+			gen.Add(
+				" The loop goes until `%s`, so `%s` reaches `%s` which is one past the last index of `%s`.",
+				ctx.rangeStop.Text(), ctx.index.Text(), ctx.lengthCall.Text(), ctx.container.Text(),
+			)
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(indexErrorCtx)
+		if ctx.subscript.IsNull() {
+			return
+		}
+
+		if !ctx.rangeStop.IsNull() {
+			gen.Add("Fix the range of the loop", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Stop the loop at `%s` so that it only goes through the indexes of `%s`.", ctx.lengthCall.Text(), ctx.container.Text()).
+					AddFix(lib.FixSuggestion{
+						NewText:       ctx.lengthCall.Text(),
+						StartPosition: ctx.rangeStop.StartPosition(),
+						EndPosition:   ctx.rangeStop.EndPosition(),
+					})
+			})
+			return
+		}
+
+		container := cd.Variables["container"]
+		value, isConstant := integerValue(ctx.index)
+		if isConstant && ctx.length > 0 {
+			gen.Add("Use a valid index", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Use an index from 0 to %d, such as %d for the last %s.", ctx.length-1, ctx.length-1, itemNoun(container, 1)).
+					AddFix(lib.FixSuggestion{
+						NewText:       strconv.Itoa(ctx.length - 1),
+						StartPosition: ctx.index.StartPosition(),
+						EndPosition:   ctx.index.EndPosition(),
+					})
+			})
+		}
+
+		condition := fmt.Sprintf("%s < len(%s)", ctx.index.Text(), ctx.container.Text())
+		if isConstant && value < 0 {
+			condition = fmt.Sprintf("len(%s) >= %d", ctx.container.Text(), -value)
+		} else if isConstant {
+			condition = fmt.Sprintf("len(%s) > %d", ctx.container.Text(), value)
+		}
+
+		addGuardFix(
+			cd.MainError.Document, gen, ctx.subscript,
+			"Check the length first", condition,
+			"Only access the %s if `%s` has enough %s.", itemNoun(container, 1), ctx.container.Text(), itemNoun(container, 2),
+		)
+	},
+}
+
+// itemNoun returns the name of the items of the container
+func itemNoun(container string, count int) string {
+	noun := "item"
+	if container == "string" {
+		noun = "character"
+	}
+
+	if count != 1 {
+		noun += "s"
+	}
+	return noun
+}
+
+// literalLength returns the number of items of the list, tuple, or string
+// literal or -1 if the node is not a literal
+func literalLength(node lib.SyntaxNode) int {
+	if node.IsNull() {
+		return -1
+	}
+
+	switch node.Type() {
+	case "list", "tuple":
+		return int(node.NamedChildCount())
+	case "string":
+		text := node.Text()
+		// strings with prefixes or escapes may have a different length
+		if !strings.HasPrefix(text, `"`) && !strings.HasPrefix(text, "'") || strings.Contains(text, `\`) || strings.HasPrefix(text, `"""`) || strings.HasPrefix(text, "'''") {
+			return -1
+		}
+		return len([]rune(text)) - 2
+	}
+	return -1
+}
+
+// integerValue returns the value of the integer literal (eg. `3` or `-1`)
+func integerValue(node lib.SyntaxNode) (int, bool) {
+	text := node.Text()
+	if node.Type() == "unary_operator" && node.ChildByFieldName("argument").Type() == "integer" {
+		text = strings.ReplaceAll(text, " ", "")
+	} else if node.Type() != "integer" {
+		return 0, false
+	}
+
+	value, err := strconv.Atoi(text)
+	return value, err == nil
+}
+
+// findRangeOverLength finds the loop which gives the variable its value and
+// returns the stop argument of its `range()` if it goes past the length of
+// the container (eg. `range(len(items) + 1)`) along with the `len()` call
+func findRangeOverLength(node lib.SyntaxNode, variable string, container string) (lib.SyntaxNode, lib.SyntaxNode) {
+	for loop := node.Parent(); !loop.IsNull(); loop = loop.Parent() {
+		if loop.Type() != "for_statement" || loop.ChildByFieldName("left").Text() != variable {
+			continue
+		}
+
+		iterable := loop.ChildByFieldName("right")
+		if iterable.Type() != "call" || iterable.ChildByFieldName("function").Text() != "range" {
+			break
+		}
+
+		arguments := iterable.ChildByFieldName("arguments")
+		if count := arguments.NamedChildCount(); count == 0 || count > 2 {
+			break
+		}
+
+		stop := arguments.NamedChild(int(arguments.NamedChildCount()) - 1)
+		if stop.Type() != "binary_operator" || stop.ChildByFieldName("operator").Text() != "+" {
+			break
+		}
+
+		lengthCall := stop.ChildByFieldName("left")
+		if lengthCall.Text() != fmt.Sprintf("len(%s)", container) {
+			break
+		}
+
+		if value, ok := integerValue(stop.ChildByFieldName("right")); ok && value > 0 {
+			return stop, lengthCall
+		}
+		break
+	}
+	return lib.SyntaxNode{}, lib.SyntaxNode{}
+}
@@ -0,0 +1,127 @@
+package python
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+// built-in functions which convert the value to another type
+var conversionFunctions = map[string]bool{
+	"int":   true,
+	"float": true,
+	"str":   true,
+	"bool":  true,
+	"list":  true,
+	"tuple": true,
+}
+
+type invalidAssignmentErrorCtx struct {
+	// the left side of the assignment which cannot be assigned to
+	target   lib.SyntaxNode
+	operator lib.SyntaxNode
+	// the right side of the assignment, if it is on the same line
+	value lib.SyntaxNode
+}
+
+var InvalidAssignmentError = lib.ErrorTemplate{
+	Name:    "InvalidAssignmentError",
+	Pattern: compileTimeError(`SyntaxError: cannot assign to (?P<target>function call|literal|expression)(?P<suggestion> here\. Maybe you meant '==' instead of '='\?)?`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		iCtx := invalidAssignmentErrorCtx{}
+		for _, node := range nodesOnErrorLine(m, "error", `(ERROR) @error`) {
+			// in conditions (eg. `if x + 1 = 2:`), the `=` and the value after it
+			// are parsed as an error after the condition
+			if operator := node.Child(0); operator.Type() == "=" && !node.PrevNamedSibling().IsNull() {
+				iCtx.target = node.PrevNamedSibling()
+				iCtx.operator = operator
+				iCtx.value = node.NamedChild(0)
+				m.Nearest = iCtx.target
+				break
+			}
+
+			operator := node.Child(int(node.ChildCount()) - 1)
+			if operator.Type() != "=" {
+				continue
+			}
+
+			iCtx.target = node.NamedChild(0)
+			iCtx.operator = operator
+			m.Nearest = iCtx.target
+
+			// the value after the `=` is parsed as a separate statement
+			if next := node.NextSibling(); next.Type() == "expression_statement" && next.StartPosition().Line == node.StartPosition().Line {
+				iCtx.value = next.NamedChild(0)
+			}
+			break
+		}
+		m.Context = iCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(invalidAssignmentErrorCtx)
+		target := "a " + cd.Variables["target"]
+		if cd.Variables["target"] == "expression" {
+			target = "an expression"
+		}
+
+		gen.Add("This error occurs when a value is assigned to something which is not a variable, such as %s. Only variables, attributes, and items of a list or a dictionary can be on the left side of `=`.", target)
+		if ctx.target.IsNull() {
+			return
+		}
+
+		if variable := convertedVariable(ctx.target); !variable.IsNull() {
+			gen.Add(" To convert the value of `%s`, `%s` has to be used on the right side of `=` instead.", variable.Text(), ctx.target.ChildByFieldName("function").Text())
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(invalidAssignmentErrorCtx)
+		if ctx.target.IsNull() {
+			return
+		}
+
+		if variable := convertedVariable(ctx.target); !variable.IsNull() && !ctx.value.IsNull() {
+			function := ctx.target.ChildByFieldName("function").Text()
+			gen.Add("Convert the value instead", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Assign to `%s` and use `%s` on the value instead.", variable.Text(), function).
+					AddFix(lib.FixSuggestion{
+						NewText:       variable.Text(),
+						StartPosition: ctx.target.StartPosition(),
+						EndPosition:   ctx.target.EndPosition(),
+					}).
+					AddFix(lib.FixSuggestion{
+						NewText:       fmt.Sprintf("%s(%s)", function, ctx.value.Text()),
+						StartPosition: ctx.value.StartPosition(),
+						EndPosition:   ctx.value.EndPosition(),
+					})
+			})
+		}
+
+		if len(cd.Variables["suggestion"]) != 0 {
+			gen.Add("Compare the values instead", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Use `==` if the values should be compared instead of assigned.").
+					AddFix(lib.FixSuggestion{
+						NewText:       "==",
+						StartPosition: ctx.operator.StartPosition(),
+						EndPosition:   ctx.operator.EndPosition(),
+					})
+			})
+		}
+	},
+}
+
+// convertedVariable returns the variable given to a conversion function
+// (eg. `age` in `int(age)`) or a null node if the node is not such call
+func convertedVariable(node lib.SyntaxNode) lib.SyntaxNode {
+	if node.IsNull() || node.Type() != "call" {
+		return lib.SyntaxNode{}
+	}
+
+	if !conversionFunctions[node.ChildByFieldName("function").Text()] {
+		return lib.SyntaxNode{}
+	}
+
+	if args := node.ChildByFieldName("arguments"); args.NamedChildCount() == 1 && args.NamedChild(0).Type() == "identifier" {
+		return args.NamedChild(0)
+	}
+	return lib.SyntaxNode{}
+}
@@ -0,0 +1,77 @@
+package python
+
+import (
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type isADirectoryErrorCtx struct {
+	pathNode lib.SyntaxNode
+	// the files inside the folder
+	files []string
+}
+
+var IsADirectoryError = lib.ErrorTemplate{
+	Name:    "IsADirectoryError",
+	Pattern: `IsADirectoryError: \[Errno 21\] Is a directory: '(?P<path>[^']*)'`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		iCtx := isADirectoryErrorCtx{}
+		path := cd.Variables["path"]
+		_, iCtx.pathNode = findOpenedPath(m, path)
+		if !iCtx.pathNode.IsNull() {
+			m.Nearest = iCtx.pathNode
+		}
+
+		iCtx.files = filesInDir(cd, resolvePath(cd, path))
+		m.Context = iCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(isADirectoryErrorCtx)
+		gen.Add("This error occurs when the program tries to open a folder as if it is a file. `%s` is a folder, and `open()` can only open files.", cd.Variables["path"])
+		if len(ctx.files) != 0 {
+			gen.Add(" The files inside it are %s.", joinNames(ctx.files))
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(isADirectoryErrorCtx)
+		path := cd.Variables["path"]
+
+		gen.Add("Open a file inside the folder", func(s *lib.BugFixSuggestion) {
+			if len(ctx.files) == 0 || ctx.pathNode.IsNull() {
+				s.AddStep("Add the name of the file to open at the end of the path (eg. `%s/data.txt`).", strings.TrimSuffix(path, "/"))
+				return
+			}
+
+			filePath := strings.TrimSuffix(path, "/") + "/" + ctx.files[0]
+			s.AddStep("Add the name of the file to open at the end of the path.").
+				AddFix(lib.FixSuggestion{
+					NewText:       replaceStringValue(ctx.pathNode, filePath),
+					StartPosition: ctx.pathNode.StartPosition(),
+					EndPosition:   ctx.pathNode.EndPosition(),
+				})
+		})
+
+		gen.Add("Go through the files in the folder", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Use `os.listdir(\"%s\")` to get the names of the files in the folder and open each of them with `os.path.join`.", path)
+		})
+	},
+}
+
+// filesInDir returns the names of the files inside the folder
+func filesInDir(cd *lib.ContextData, dir string) []string {
+	if cd.FS == nil {
+		return nil
+	}
+
+	files := []string{}
+	entries, _ := fs.ReadDir(cd.FS, filepath.ToSlash(dir))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			files = append(files, entry.Name())
+		}
+	}
+	return files
+}
@@ -0,0 +1,154 @@
+package python
+
+import (
+	"fmt"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/utils/levenshtein"
+)
+
+type keyErrorCtx struct {
+	subscript  lib.SyntaxNode
+	dictionary lib.SyntaxNode
+	key        lib.SyntaxNode
+	// the keys of the dictionary if it is given a literal
+	keys []lib.SyntaxNode
+	// the key of the dictionary which is written similar to the missing key (eg. `"Name"` for `"name"`)
+	similarKey string
+}
+
+var KeyError = lib.ErrorTemplate{
+	Name:    "KeyError",
+	Pattern: `KeyError: (?P<key>.+)`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		kCtx := keyErrorCtx{}
+		key := cd.Variables["key"]
+		subscripts := nodesOnErrorLine(m, "subscript", `(subscript) @subscript`)
+		for _, subscript := range subscripts {
+			if literalValue(subscript.ChildByFieldName("subscript")) == unquote(key) {
+				kCtx.subscript = subscript
+				break
+			}
+		}
+
+		// keys given through variables cannot be compared with the error message
+		if kCtx.subscript.IsNull() && len(subscripts) != 0 {
+			kCtx.subscript = subscripts[0]
+		}
+
+		if kCtx.subscript.IsNull() {
+			m.Context = kCtx
+			return
+		}
+
+		m.Nearest = kCtx.subscript
+		kCtx.dictionary = kCtx.subscript.ChildByFieldName("value")
+		kCtx.key = kCtx.subscript.ChildByFieldName("subscript")
+
+		// only string keys can be misspelled. numbers which are close to each
+		// other (eg. `1` and `5`) are different keys so they are listed instead
+		isStringKey := unquote(key) != key
+		if value := findValueOrigin(cd, m.Document, kCtx.dictionary).value; !value.IsNull() && value.Type() == "dictionary" {
+			nearestDistance := -1
+			for i := 0; i < int(value.NamedChildCount()); i++ {
+				pair := value.NamedChild(i)
+				if pair.Type() != "pair" {
+					continue
+				}
+
+				dictKey := pair.ChildByFieldName("key")
+				kCtx.keys = append(kCtx.keys, dictKey)
+				if !isStringKey || dictKey.Type() != "string" {
+					continue
+				}
+
+				distance := levenshtein.ComputeDistance(strings.ToLower(unquote(key)), strings.ToLower(literalValue(dictKey)))
+				if distance > 2 || (nearestDistance != -1 && distance >= nearestDistance) {
+					continue
+				}
+
+				kCtx.similarKey = dictKey.Text()
+				nearestDistance = distance
+			}
+		}
+
+		m.Context = kCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(keyErrorCtx)
+		gen.Add("This error occurs when a dictionary is accessed with a key that it does not have.")
+		if ctx.subscript.IsNull() {
+			return
+		}
+
+		gen.Add(" `%s` does not have the key `%s`.", ctx.dictionary.Text(), cd.Variables["key"])
+		if len(ctx.similarKey) != 0 && strings.EqualFold(unquote(ctx.similarKey), unquote(cd.Variables["key"])) {
+			gen.Add(" It has the key `%s` instead, which is different since keys are case-sensitive.", ctx.similarKey)
+		} else if len(ctx.similarKey) != 0 {
+			gen.Add(" It has a similar key, `%s`, which may be the one that is meant.", ctx.similarKey)
+		} else if len(ctx.keys) != 0 {
+			keys := make([]string, len(ctx.keys))
+			for i, key := range ctx.keys {
+				keys[i] = key.Text()
+			}
+			gen.Add(" It only has the keys %s.", joinNames(keys))
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(keyErrorCtx)
+		if ctx.subscript.IsNull() {
+			return
+		}
+
+		if len(ctx.similarKey) != 0 {
+			gen.Add("Use the correct key", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Use `%s` which is the key written in `%s`.", ctx.similarKey, ctx.dictionary.Text()).
+					AddFix(lib.FixSuggestion{
+						NewText:       ctx.similarKey,
+						StartPosition: ctx.key.StartPosition(),
+						EndPosition:   ctx.key.EndPosition(),
+					})
+			})
+		}
+
+		// values cannot be assigned or deleted with get()
+		if parent := ctx.subscript.Parent(); !(parent.Type() == "assignment" && parent.ChildByFieldName("left").StartByte() == ctx.subscript.StartByte()) && parent.Type() != "delete_statement" {
+			gen.Add("Use get() with a default value", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Use `get()` which gives a default value instead of an error when the key is missing.").
+					AddFix(lib.FixSuggestion{
+						NewText:       fmt.Sprintf("%s.get(%s, None)", ctx.dictionary.Text(), ctx.key.Text()),
+						StartPosition: ctx.subscript.StartPosition(),
+						EndPosition:   ctx.subscript.EndPosition(),
+						Description:   "Replace `None` with the value to use when the key is missing.",
+					})
+			})
+		}
+
+		addGuardFix(
+			cd.MainError.Document, gen, ctx.subscript,
+			"Check if the key exists", fmt.Sprintf("%s in %s", ctx.key.Text(), ctx.dictionary.Text()),
+			"Only access the key if `%s` has it.", ctx.dictionary.Text(),
+		)
+	},
+}
+
+// literalValue returns the value of the string or number literal without
+// the quotes or an empty string if the node is not a literal
+func literalValue(node lib.SyntaxNode) string {
+	switch node.Type() {
+	case "string":
+		return unquote(node.Text())
+	case "integer", "float":
+		return node.Text()
+	}
+	return ""
+}
+
+// unquote removes the quotes around the string (eg. `'name'`)
+func unquote(str string) string {
+	if len(str) >= 2 && (str[0] == '"' || str[0] == '\'') && str[len(str)-1] == str[0] {
+		return str[1 : len(str)-1]
+	}
+	return str
+}
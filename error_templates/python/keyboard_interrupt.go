@@ -0,0 +1,242 @@
+package python
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type loopProblemKind int
+
+const (
+	loopProblemUnknown loopProblemKind = iota
+	// a `while True` loop without a `break` or a `return` in it
+	loopWithoutExit
+	// a loop whose condition only uses variables which are not changed in it
+	loopWithoutProgress
+)
+
+type keyboardInterruptCtx struct {
+	kind loopProblemKind
+	loop lib.SyntaxNode
+	// the variable in the condition of the loop which is not changed in it
+	variable string
+	// whether the program is stopped while waiting for the input of `input()`
+	waitsForInput bool
+}
+
+// Programs which are stopped by a timeout (eg. `timeout -s INT 5 python3 main.py`)
+// print the same message, so the loops which never stop are checked for both.
+var KeyboardInterrupt = lib.ErrorTemplate{
+	Name:    "KeyboardInterrupt",
+	Pattern: `KeyboardInterrupt`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		kCtx := keyboardInterruptCtx{}
+
+		// the program may be stopped inside a function called by the loop so
+		// the frames before the most recent one are checked as well
+		for i := len(cd.TraceStack) - 1; i >= 0 && kCtx.loop.IsNull(); i-- {
+			frame := cd.TraceStack[i]
+			if frame.DocumentPath != m.DocumentPath() {
+				continue
+			}
+
+			line := frame.StartPos.Line - 1
+			node := m.Document.RootNode().NamedDescendantForPointRange(lib.Location{
+				StartPos: lib.Position{Line: line},
+				EndPos:   lib.Position{Line: line},
+			})
+
+			for ; !node.IsNull(); node = node.Parent() {
+				if kind, variable := analyzeLoop(m.Document, node); kind != loopProblemUnknown {
+					kCtx.kind, kCtx.loop, kCtx.variable = kind, node, variable
+					m.Nearest = node.ChildByFieldName("condition")
+					break
+				}
+			}
+		}
+
+		if calls := callsOnErrorLine(m, "input"); kCtx.loop.IsNull() && len(calls) != 0 {
+			kCtx.waitsForInput = true
+			m.Nearest = calls[0]
+		}
+
+		m.Context = kCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(keyboardInterruptCtx)
+		gen.Add("This error occurs when the program is stopped while it is still running, such as when Ctrl+C is pressed or when it takes too long to finish.")
+
+		switch ctx.kind {
+		case loopWithoutExit:
+			gen.Add(
+				" The `while %s` loop on line %d does not have a `break` or a `return` in it, so it never stops.",
+				ctx.loop.ChildByFieldName("condition").Text(), ctx.loop.StartPosition().Line+1,
+			)
+		case loopWithoutProgress:
+			gen.Add(
+				" The condition of the loop on line %d, `%s`, depends on `%s` which is not changed inside the loop, so the condition stays the same forever.",
+				ctx.loop.StartPosition().Line+1, ctx.loop.ChildByFieldName("condition").Text(), ctx.variable,
+			)
+		default:
+			if ctx.waitsForInput {
+				gen.Add(" The program was stopped while it was waiting for input from `input()`.")
+			}
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(keyboardInterruptCtx)
+		if ctx.loop.IsNull() {
+			gen.Add("Let the program finish", func(s *lib.BugFixSuggestion) {
+				if ctx.waitsForInput {
+					s.AddStep("Type the input which the program asks for and press Enter instead of stopping it.")
+					return
+				}
+				s.AddStep("If the program was not stopped on purpose, run it again and wait until it is done.")
+			})
+			return
+		}
+
+		body := ctx.loop.ChildByFieldName("body")
+		doc := cd.MainError.Document
+
+		switch ctx.kind {
+		case loopWithoutExit:
+			gen.Add("Stop the loop with break", func(s *lib.BugFixSuggestion) {
+				// the loop is stopped when the condition of the if statement in it is met
+				for i := 0; i < int(body.NamedChildCount()); i++ {
+					if statement := body.NamedChild(i); statement.Type() == "if_statement" {
+						consequence := statement.ChildByFieldName("consequence")
+						last := consequence.LastNamedChild()
+						spaces := doc.LineAt(last.StartPosition().Line)[:last.StartPosition().Column]
+						s.AddStep("Add a `break` to stop the loop when `%s` is true.", statement.ChildByFieldName("condition").Text()).
+							AddFix(lib.FixSuggestion{
+								NewText:       "\n" + spaces + "break",
+								StartPosition: last.EndPosition(),
+								EndPosition:   last.EndPosition(),
+							})
+						return
+					}
+				}
+
+				s.AddStep("Add an `if` statement with a `break` inside the loop for when the loop should stop.")
+			})
+		case loopWithoutProgress:
+			condition := ctx.loop.ChildByFieldName("condition")
+			operator := ""
+			if condition.Type() == "comparison_operator" && condition.NamedChild(0).Text() == ctx.variable {
+				switch condition.Child(1).Type() {
+				case "<", "<=", "!=":
+					operator = "+="
+				case ">", ">=":
+					operator = "-="
+				}
+			}
+
+			gen.Add("Update the variable in the loop", func(s *lib.BugFixSuggestion) {
+				if len(operator) == 0 {
+					s.AddStep("Change the value of `%s` inside the loop so that `%s` eventually becomes false.", ctx.variable, condition.Text())
+					return
+				}
+
+				last := body.LastNamedChild()
+				spaces := doc.LineAt(last.StartPosition().Line)[:last.StartPosition().Column]
+				s.AddStep("Change the value of `%s` at the end of the loop so that `%s` eventually becomes false.", ctx.variable, condition.Text()).
+					AddFix(lib.FixSuggestion{
+						NewText:       fmt.Sprintf("\n%s%s %s 1", spaces, ctx.variable, operator),
+						StartPosition: last.EndPosition(),
+						EndPosition:   last.EndPosition(),
+					})
+			})
+		}
+	},
+}
+
+// analyzeLoop checks if the `while` loop never stops and returns the variable
+// in its condition which is not changed in it, if any
+func analyzeLoop(doc *lib.Document, loop lib.SyntaxNode) (loopProblemKind, string) {
+	if loop.Type() != "while_statement" || loopHasExit(loop) {
+		return loopProblemUnknown, ""
+	}
+
+	condition := loop.ChildByFieldName("condition")
+	if condition.Type() == "true" || (condition.Type() == "integer" && condition.Text() != "0") {
+		return loopWithoutExit, ""
+	}
+
+	// the values returned by functions may change on every call
+	for q := condition.Query(`(call) @call`); q.Next(); {
+		return loopProblemUnknown, ""
+	}
+
+	variables := []string{}
+	for q := condition.Query(`(identifier) @name`); q.Next(); {
+		variables = append(variables, q.CurrentNode().Text())
+	}
+
+	if condition.Type() == "identifier" {
+		variables = []string{condition.Text()}
+	}
+
+	if len(variables) == 0 {
+		return loopProblemUnknown, ""
+	}
+
+	for _, variable := range variables {
+		if isChangedIn(loop.ChildByFieldName("body"), variable) {
+			return loopProblemUnknown, ""
+		}
+
+		// functions which declare the variable as global may change it when called
+		for q := doc.RootNode().Query(`(global_statement (identifier) @name (#eq? @name "%s"))`, variable); q.Next(); {
+			return loopProblemUnknown, ""
+		}
+	}
+	return loopWithoutProgress, variables[0]
+}
+
+// loopHasExit checks if the loop has a `break`, `return`, or `raise` which stops it
+func loopHasExit(loop lib.SyntaxNode) bool {
+	for q := loop.ChildByFieldName("body").Query(`[(break_statement) (return_statement) (raise_statement)] @exit`); q.Next(); {
+		node := q.CurrentNode()
+		if node.Type() != "break_statement" {
+			return true
+		}
+
+		// breaks of nested loops only stop the nested loop
+		parent := node.Parent()
+		for parent.Type() != "while_statement" && parent.Type() != "for_statement" {
+			parent = parent.Parent()
+		}
+
+		if parent.StartByte() == loop.StartByte() {
+			return true
+		}
+	}
+
+	for q := loop.ChildByFieldName("body").Query(`(call function: [(identifier) (attribute)] @name (#match? @name "^(exit|quit|sys.exit)$"))`); q.Next(); {
+		return true
+	}
+	return false
+}
+
+// isChangedIn checks if the variable is assigned, deleted, or changed with
+// one of its methods (eg. `items.pop()`) in the node
+func isChangedIn(node lib.SyntaxNode, variable string) bool {
+	for q := node.Query(`[
+		(assignment left: (_) @target)
+		(augmented_assignment left: (_) @target)
+		(for_statement left: (_) @target)
+		(delete_statement (_) @target)
+		(call function: (attribute object: (_) @target))
+	]`); q.Next(); {
+		for iq := q.CurrentNode().Query(`((identifier) @name (#eq? @name "%s"))`, variable); iq.Next(); {
+			return true
+		}
+
+		if target := q.CurrentNode(); target.Type() == "identifier" && target.Text() == variable {
+			return true
+		}
+	}
+	return false
+}
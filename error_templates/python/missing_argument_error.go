@@ -0,0 +1,173 @@
+package python
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+// matches the names in the list of missing arguments (eg. `'name' and 'age'`)
+var argumentNamePattern = regexp.MustCompile(`'(\w+)'`)
+
+type missingArgumentErrorCtx struct {
+	call       lib.SyntaxNode
+	definition lib.SyntaxNode
+	arguments  []string
+	// the method is called on the class instead of an object (eg. `Dog.bark()`)
+	calledOnClass bool
+}
+
+var MissingArgumentError = lib.ErrorTemplate{
+	Name:    "MissingArgumentError",
+	Pattern: `TypeError: (?P<function>\S+)\(\) missing (?P<count>\d+) required positional arguments?: (?P<arguments>.+)`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		mCtx := missingArgumentErrorCtx{}
+		function := cd.Variables["function"]
+		for _, match := range argumentNamePattern.FindAllStringSubmatch(cd.Variables["arguments"], -1) {
+			mCtx.arguments = append(mCtx.arguments, match[1])
+		}
+
+		for _, call := range callsOnErrorLine(m, function) {
+			mCtx.call = call
+			m.Nearest = call
+			break
+		}
+
+		if mCtx.call.IsNull() {
+			m.Context = mCtx
+			return
+		}
+
+		mCtx.definition = findFunction(cd, m.Document, function, int(mCtx.call.StartByte()))
+		if callee := mCtx.call.ChildByFieldName("function"); callee.Type() == "attribute" && len(mCtx.arguments) == 1 && mCtx.arguments[0] == "self" {
+			object := callee.ChildByFieldName("object")
+			if sym := cd.FindSymbol(object.Text(), int(object.StartByte())); sym != nil && sym.Kind() == lib.SymbolKindClass {
+				mCtx.calledOnClass = true
+			}
+		}
+
+		m.Context = mCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(missingArgumentErrorCtx)
+		if ctx.calledOnClass {
+			object := ctx.call.ChildByFieldName("function").ChildByFieldName("object").Text()
+			gen.Add(
+				"This error occurs when a method is called on a class instead of an object of the class. `%s` needs an object to work with, which is given through `self` when it is called on an object such as `%s()`.",
+				cd.Variables["function"], object,
+			)
+			return
+		}
+
+		gen.Add("This error occurs when a function is called without giving a value for each of its required parameters.")
+		if len(ctx.arguments) != 0 {
+			gen.Add(" `%s` also needs %s.", cd.Variables["function"], joinNames(ctx.arguments))
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(missingArgumentErrorCtx)
+		if ctx.call.IsNull() {
+			return
+		}
+
+		if ctx.calledOnClass {
+			object := ctx.call.ChildByFieldName("function").ChildByFieldName("object")
+			gen.Add("Create an object first", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Create an object of `%s` and call `%s` on it.", object.Text(), ctx.definition.ChildByFieldName("name").Text()).
+					AddFix(lib.FixSuggestion{
+						NewText:       object.Text() + "()",
+						StartPosition: object.StartPosition(),
+						EndPosition:   object.EndPosition(),
+					})
+			})
+			return
+		}
+
+		if len(ctx.arguments) == 0 {
+			return
+		}
+
+		gen.Add("Add the missing arguments", func(s *lib.BugFixSuggestion) {
+			argList := ctx.call.ChildByFieldName("arguments")
+			missing := make([]string, len(ctx.arguments))
+			for i, name := range ctx.arguments {
+				missing[i] = name + "=None"
+			}
+
+			newText := strings.Join(missing, ", ")
+			if argList.NamedChildCount() != 0 {
+				newText = ", " + newText
+			}
+
+			end := argList.EndPosition().Add(lib.Position{Column: -1, Index: -1})
+			s.AddStep("Give a value for %s when calling `%s`.", joinNames(ctx.arguments), cd.Variables["function"]).
+				AddFix(lib.FixSuggestion{
+					NewText:       newText,
+					StartPosition: end,
+					EndPosition:   end,
+					Description:   "Replace `None` with the values that the function should use.",
+				})
+		})
+
+		params := missingParameters(ctx.definition, ctx.arguments)
+		if len(params) == 0 {
+			return
+		}
+
+		gen.Add("Give the parameters a default value", func(s *lib.BugFixSuggestion) {
+			step := s.AddStep("Add a default value to %s so that `%s` can be called without giving a value for them.", joinNames(ctx.arguments), cd.Variables["function"])
+			for _, param := range params {
+				step.AddFix(lib.FixSuggestion{
+					NewText:       "=None",
+					StartPosition: param.EndPosition(),
+					EndPosition:   param.EndPosition(),
+				})
+			}
+		})
+	},
+}
+
+// missingParameters returns the parameters of the function with the given
+// names. Parameters with default values can only be followed by other
+// parameters with default values so nothing is returned if they are not
+// the last parameters of the function.
+func missingParameters(function lib.SyntaxNode, names []string) []lib.SyntaxNode {
+	if function.IsNull() {
+		return nil
+	}
+
+	params := function.ChildByFieldName("parameters")
+	count := int(params.NamedChildCount())
+	if count < len(names) {
+		return nil
+	}
+
+	found := []lib.SyntaxNode{}
+	for i := count - len(names); i < count; i++ {
+		param := params.NamedChild(i)
+		if param.Type() != "identifier" || param.Text() != names[len(found)] {
+			return nil
+		}
+		found = append(found, param)
+	}
+	return found
+}
+
+// joinNames formats the names as a list (eg. "`a`, `b`, and `c`")
+func joinNames(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = fmt.Sprintf("`%s`", name)
+	}
+
+	switch len(quoted) {
+	case 1:
+		return quoted[0]
+	case 2:
+		return quoted[0] + " and " + quoted[1]
+	default:
+		return strings.Join(quoted[:len(quoted)-1], ", ") + ", and " + quoted[len(quoted)-1]
+	}
+}
@@ -0,0 +1,64 @@
+package python
+
+import (
+	lib "github.com/nedpals/errgoengine"
+)
+
+type missingColonErrorCtx struct {
+	// the keyword which starts the block (eg. `if`)
+	keyword lib.SyntaxNode
+	// the last node of the line where the colon should be added after
+	last lib.SyntaxNode
+}
+
+var MissingColonError = lib.ErrorTemplate{
+	Name:    "MissingColonError",
+	Pattern: compileTimeError(`SyntaxError: expected ':'`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		mCtx := missingColonErrorCtx{}
+		errorLine := m.ErrorNode.StartPos.Line - 1
+		for _, node := range nodesOnErrorLine(m, "error", `(ERROR) @error`) {
+			mCtx.last = lastNodeOnLine(node, errorLine)
+			if first := node.Child(0); !first.IsNamed() {
+				mCtx.keyword = first
+			}
+			break
+		}
+
+		// statements which are parsed without errors (eg. `else`) are looked
+		// up from the line itself
+		if mCtx.last.IsNull() {
+			for _, node := range nodesOnErrorLine(m, "statement", `[(if_statement) (for_statement) (while_statement) (function_definition) (class_definition) (else_clause) (elif_clause) (try_statement) (with_statement)] @statement`) {
+				mCtx.keyword = node.Child(0)
+				mCtx.last = lastNodeOnLine(node, errorLine)
+			}
+		}
+
+		if !mCtx.last.IsNull() {
+			m.Nearest = mCtx.last
+		}
+		m.Context = mCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(missingColonErrorCtx)
+		gen.Add("This error occurs when the colon (`:`) at the end of a line which starts a block of code, such as an `if` statement, a loop, or a function definition, is missing.")
+		if !ctx.keyword.IsNull() {
+			gen.Add(" The `%s` statement on line %d needs a colon before the indented code under it.", ctx.keyword.Text(), ctx.keyword.StartPosition().Line+1)
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(missingColonErrorCtx)
+		if ctx.last.IsNull() {
+			return
+		}
+
+		gen.Add("Add the missing colon", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Add a colon (`:`) at the end of the line.").
+				AddFix(lib.FixSuggestion{
+					NewText:       ":",
+					StartPosition: ctx.last.EndPosition(),
+					EndPosition:   ctx.last.EndPosition(),
+				})
+		})
+	},
+}
@@ -0,0 +1,50 @@
+package python
+
+import (
+	lib "github.com/nedpals/errgoengine"
+)
+
+type missingCommaErrorCtx struct {
+	// the items which are not separated by a comma
+	before lib.SyntaxNode
+	after  lib.SyntaxNode
+}
+
+var MissingCommaError = lib.ErrorTemplate{
+	Name:    "MissingCommaError",
+	Pattern: compileTimeError(`SyntaxError: invalid syntax\. Perhaps you forgot a comma\?`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		mCtx := missingCommaErrorCtx{}
+		for _, node := range nodesOnErrorLine(m, "error", `(ERROR) @error`) {
+			if prev := node.PrevSibling(); !prev.IsNull() && prev.IsNamed() {
+				mCtx.before = prev
+				mCtx.after = node
+				m.Nearest = node
+				break
+			}
+		}
+		m.Context = mCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(missingCommaErrorCtx)
+		gen.Add("This error occurs when the items of a list, a tuple, a dictionary, or the arguments of a function call are not separated by commas.")
+		if !ctx.before.IsNull() {
+			gen.Add(" `%s` and `%s` need a comma between them.", ctx.before.Text(), ctx.after.Text())
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(missingCommaErrorCtx)
+		if ctx.before.IsNull() {
+			return
+		}
+
+		gen.Add("Add the missing comma", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Add a comma after `%s` to separate it from `%s`.", ctx.before.Text(), ctx.after.Text()).
+				AddFix(lib.FixSuggestion{
+					NewText:       ",",
+					StartPosition: ctx.before.EndPosition(),
+					EndPosition:   ctx.before.EndPosition(),
+				})
+		})
+	},
+}
@@ -0,0 +1,50 @@
+package python
+
+import (
+	lib "github.com/nedpals/errgoengine"
+)
+
+type missingPrintParenthesesErrorCtx struct {
+	statement lib.SyntaxNode
+}
+
+var MissingPrintParenthesesError = lib.ErrorTemplate{
+	Name:    "MissingPrintParenthesesError",
+	Pattern: compileTimeError(`SyntaxError: Missing parentheses in call to '(?P<function>print|exec)'\. Did you mean .+\?`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		mCtx := missingPrintParenthesesErrorCtx{}
+		for _, node := range nodesOnErrorLine(m, "statement", `[(print_statement) (exec_statement)] @statement`) {
+			mCtx.statement = node
+			m.Nearest = node
+			break
+		}
+		m.Context = mCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		gen.Add(
+			"This error occurs when `%s` is used without parentheses, which only works in Python 2. In Python 3, `%s` is a function so the values given to it have to be inside parentheses.",
+			cd.Variables["function"], cd.Variables["function"],
+		)
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(missingPrintParenthesesErrorCtx)
+		if ctx.statement.IsNull() || ctx.statement.NamedChildCount() == 0 {
+			return
+		}
+
+		gen.Add("Add the parentheses", func(s *lib.BugFixSuggestion) {
+			keyword := ctx.statement.Child(0)
+			s.AddStep("Put the values given to `%s` inside parentheses.", cd.Variables["function"]).
+				AddFix(lib.FixSuggestion{
+					NewText:       "(",
+					StartPosition: keyword.EndPosition(),
+					EndPosition:   ctx.statement.NamedChild(0).StartPosition(),
+				}).
+				AddFix(lib.FixSuggestion{
+					NewText:       ")",
+					StartPosition: ctx.statement.EndPosition(),
+					EndPosition:   ctx.statement.EndPosition(),
+				})
+		})
+	},
+}
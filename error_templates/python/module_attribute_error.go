@@ -0,0 +1,128 @@
+package python
+
+import (
+	"path/filepath"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/languages/python"
+)
+
+// attributes of the modules of the standard library which are commonly used
+var moduleAttributes = map[string][]string{
+	"math": {
+		"ceil", "comb", "cos", "degrees", "e", "exp", "factorial", "floor", "fsum",
+		"gcd", "hypot", "inf", "isclose", "isqrt", "lcm", "log", "log10", "log2",
+		"nan", "perm", "pi", "pow", "prod", "radians", "sin", "sqrt", "tan", "tau", "trunc",
+	},
+	"random":     {"choice", "choices", "randint", "random", "randrange", "sample", "seed", "shuffle", "uniform"},
+	"time":       {"localtime", "perf_counter", "sleep", "strftime", "time"},
+	"os":         {"environ", "getcwd", "getenv", "listdir", "makedirs", "mkdir", "path", "remove", "rename", "walk"},
+	"sys":        {"argv", "exit", "path", "platform", "stdin", "stdout", "version"},
+	"json":       {"dump", "dumps", "load", "loads"},
+	"string":     {"ascii_letters", "ascii_lowercase", "ascii_uppercase", "digits", "punctuation", "whitespace"},
+	"datetime":   {"date", "datetime", "time", "timedelta", "timezone"},
+	"statistics": {"mean", "median", "mode", "stdev", "variance"},
+}
+
+type moduleAttributeErrorKind int
+
+const (
+	moduleAttributeErrorKindUnknown moduleAttributeErrorKind = 0
+	// a file in the project has the same name as a module of the standard library
+	moduleAttributeErrorKindShadowing moduleAttributeErrorKind = iota
+	// the module is used while it is still being imported
+	moduleAttributeErrorKindCircular moduleAttributeErrorKind = iota
+	moduleAttributeErrorKindMissing  moduleAttributeErrorKind = iota
+)
+
+type moduleAttributeErrorCtx struct {
+	kind      moduleAttributeErrorKind
+	attribute lib.SyntaxNode
+	// the path of the file of the module in the project, if any
+	modulePath string
+	// the name in the module which is similar to the missing attribute
+	similarName string
+}
+
+var ModuleAttributeError = lib.ErrorTemplate{
+	Name:    "ModuleAttributeError",
+	Pattern: `AttributeError: (?P<partial>partially initialized )?module '(?P<module>[^']+)' has no attribute '(?P<attribute>[^']+)'(?:\. Did you mean: '[^']+'\?)?(?: \(.+\))?`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		mCtx := moduleAttributeErrorCtx{}
+		module, attribute := cd.Variables["module"], cd.Variables["attribute"]
+		for _, node := range nodesOnErrorLine(m, "attribute", `(attribute attribute: (identifier) @name (#eq? @name "%s")) @attribute`, attribute) {
+			mCtx.attribute = node
+			m.Nearest = node
+			break
+		}
+
+		mCtx.modulePath = python.ResolveModulePath(cd.FS, filepath.Dir(m.DocumentPath()), module)
+		switch {
+		case isStdlibModule(module) && len(mCtx.modulePath) != 0:
+			mCtx.kind = moduleAttributeErrorKindShadowing
+		case len(cd.Variables["partial"]) != 0:
+			mCtx.kind = moduleAttributeErrorKindCircular
+		case len(mCtx.modulePath) != 0:
+			mCtx.kind = moduleAttributeErrorKindMissing
+			mCtx.similarName = similarModuleName(cd, mCtx.modulePath, attribute)
+		default:
+			mCtx.kind = moduleAttributeErrorKindMissing
+			mCtx.similarName = similarName(attribute, moduleAttributes[module])
+		}
+
+		m.Context = mCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(moduleAttributeErrorCtx)
+		module, attribute := cd.Variables["module"], cd.Variables["attribute"]
+		switch ctx.kind {
+		case moduleAttributeErrorKindShadowing:
+			gen.Add("This error occurs when a file in your project has the same name as a module of Python's standard library.")
+			gen.Add(" Python imports `%s` instead of the `%s` module that comes with Python, and `%s` is not in it.", ctx.modulePath, module, attribute)
+		case moduleAttributeErrorKindCircular:
+			gen.Add("This error occurs when a module is used while it is still being imported, which happens when modules import each other (also known as a circular import).")
+			gen.Add(" `%s` is not defined in `%s` yet when it is used.", attribute, module)
+		default:
+			gen.Add("This error occurs when a name is used from a module which does not have it.")
+			if len(ctx.similarName) != 0 {
+				gen.Add(" The `%s` module does not have `%s`, but it has `%s` which has a similar name.", module, attribute, ctx.similarName)
+			} else {
+				gen.Add(" The `%s` module does not have anything named `%s`.", module, attribute)
+			}
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(moduleAttributeErrorCtx)
+		module, attribute := cd.Variables["module"], cd.Variables["attribute"]
+		switch ctx.kind {
+		case moduleAttributeErrorKindShadowing:
+			gen.Add("Rename the file", func(s *lib.BugFixSuggestion) {
+				s.AddStep(
+					"Rename `%s` to a name which is not used by Python's modules, such as `my_%s`. Delete the `__pycache__` folder next to it as well if there is one.",
+					ctx.modulePath, filepath.Base(ctx.modulePath),
+				)
+			})
+		case moduleAttributeErrorKindCircular:
+			gen.Add("Break the circular import", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Move the code which both modules need into a separate module, or import `%s` inside the function which uses `%s`.", module, attribute)
+			})
+		default:
+			if len(ctx.similarName) == 0 || ctx.attribute.IsNull() {
+				gen.Add("Check the name", func(s *lib.BugFixSuggestion) {
+					s.AddStep("Make sure that `%s` is spelled correctly and that it is defined in `%s`.", attribute, module)
+				})
+				return
+			}
+
+			name := ctx.attribute.ChildByFieldName("attribute")
+			gen.Add("Fix the name", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Use `%s` from `%s` instead.", ctx.similarName, module).
+					AddFix(lib.FixSuggestion{
+						NewText:       ctx.similarName,
+						StartPosition: name.StartPosition(),
+						EndPosition:   name.EndPosition(),
+					})
+			})
+		}
+	},
+}
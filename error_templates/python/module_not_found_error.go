@@ -0,0 +1,184 @@
+package python
+
+import (
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/languages/python"
+	"github.com/nedpals/errgoengine/utils/levenshtein"
+)
+
+// modules of the standard library which are commonly imported
+var stdlibModules = []string{
+	"abc", "argparse", "array", "ast", "asyncio", "base64", "bisect", "calendar",
+	"cmath", "code", "collections", "copy", "csv", "dataclasses", "datetime",
+	"decimal", "email", "enum", "fractions", "functools", "glob", "hashlib",
+	"heapq", "html", "http", "importlib", "inspect", "io", "itertools", "json",
+	"logging", "math", "operator", "os", "pathlib", "pickle", "platform",
+	"pprint", "queue", "random", "re", "secrets", "select", "shutil", "signal",
+	"socket", "sqlite3", "statistics", "string", "struct", "subprocess", "sys",
+	"tempfile", "textwrap", "threading", "time", "timeit", "tkinter", "token",
+	"traceback", "turtle", "types", "typing", "unittest", "urllib", "uuid",
+	"warnings", "zipfile",
+}
+
+// packages which are imported with a different name than the one used to
+// install them
+var packageNames = map[string]string{
+	"cv2":      "opencv-python",
+	"PIL":      "Pillow",
+	"sklearn":  "scikit-learn",
+	"yaml":     "PyYAML",
+	"bs4":      "beautifulsoup4",
+	"dotenv":   "python-dotenv",
+	"dateutil": "python-dateutil",
+}
+
+// third-party packages which are commonly imported
+var thirdPartyModules = []string{
+	"django", "flask", "matplotlib", "numpy", "pandas", "pygame", "pytest",
+	"requests", "scipy", "seaborn", "torch", "tensorflow",
+}
+
+type moduleNotFoundErrorCtx struct {
+	importNode lib.SyntaxNode
+	moduleNode lib.SyntaxNode
+	// the module which is named similar to the missing module (eg. `math` for `maths`)
+	similarModule string
+}
+
+var ModuleNotFoundError = lib.ErrorTemplate{
+	Name:    "ModuleNotFoundError",
+	Pattern: `ModuleNotFoundError: No module named '(?P<module>[^']+)'`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		mCtx := moduleNotFoundErrorCtx{}
+		module := cd.Variables["module"]
+		mCtx.importNode, mCtx.moduleNode = findImport(m, module)
+		if !mCtx.moduleNode.IsNull() {
+			m.Nearest = mCtx.moduleNode
+		}
+
+		// look for a module with a similar name (eg. typos)
+		topLevel := strings.Split(module, ".")[0]
+		candidates := append(localModules(cd, filepath.Dir(m.DocumentPath())), stdlibModules...)
+		candidates = append(candidates, thirdPartyModules...)
+		packageModules := []string{}
+		for name := range packageNames {
+			packageModules = append(packageModules, name)
+		}
+		sort.Strings(packageModules)
+		candidates = append(candidates, packageModules...)
+
+		nearestDistance := -1
+		for _, candidate := range candidates {
+			distance := levenshtein.ComputeDistance(strings.ToLower(topLevel), strings.ToLower(candidate))
+			if distance == 0 && candidate == topLevel || distance > 2 || (nearestDistance != -1 && distance >= nearestDistance) {
+				continue
+			}
+
+			mCtx.similarModule = candidate
+			nearestDistance = distance
+		}
+
+		m.Context = mCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(moduleNotFoundErrorCtx)
+		module := cd.Variables["module"]
+		gen.Add("This error occurs when Python cannot find the module that is imported.")
+		if len(ctx.similarModule) != 0 {
+			gen.Add(" There is no module named `%s`, but there is a module named `%s` which has a similar name.", module, ctx.similarModule)
+			return
+		}
+
+		gen.Add(" `%s` is not part of Python's standard library or your project, so it needs to be installed before it can be imported.", module)
+		if name := packageName(module); name != strings.Split(module, ".")[0] {
+			gen.Add(" The package which provides `%s` is named `%s`.", module, name)
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(moduleNotFoundErrorCtx)
+		if len(ctx.similarModule) != 0 {
+			if ctx.moduleNode.IsNull() {
+				return
+			}
+
+			gen.Add("Fix the module name", func(s *lib.BugFixSuggestion) {
+				// only the first part of the module name is replaced (eg. `maths` in `maths.sqrt`)
+				first := ctx.moduleNode
+				if first.Type() == "dotted_name" {
+					first = first.NamedChild(0)
+				}
+
+				s.AddStep("Import `%s` instead.", ctx.similarModule).
+					AddFix(lib.FixSuggestion{
+						NewText:       ctx.similarModule,
+						StartPosition: first.StartPosition(),
+						EndPosition:   first.EndPosition(),
+					})
+			})
+			return
+		}
+
+		gen.Add("Install the package", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Run `pip install %s` in the terminal to install the package, then run the program again.", packageName(cd.Variables["module"]))
+		})
+	},
+}
+
+// findImport returns the import statement on the line of the error which
+// imports the module along with the node of the module name
+func findImport(m *lib.MainError, module string) (lib.SyntaxNode, lib.SyntaxNode) {
+	for _, node := range nodesOnErrorLine(m, "import", `[(import_statement) (import_from_statement)] @import`) {
+		for q := node.Query(`[(dotted_name) (relative_import)] @name`); q.Next(); {
+			name := q.CurrentNode()
+			if text := strings.TrimLeft(name.Text(), "."); text == module || strings.HasPrefix(text, module+".") || strings.HasPrefix(module, text+".") {
+				return node, name
+			}
+		}
+	}
+	return lib.SyntaxNode{}, lib.SyntaxNode{}
+}
+
+// localModules returns the names of the modules in the directory
+func localModules(cd *lib.ContextData, dir string) []string {
+	if cd.FS == nil {
+		return nil
+	}
+
+	modules := []string{}
+	entries, _ := fs.ReadDir(cd.FS, filepath.ToSlash(dir))
+	for _, entry := range entries {
+		if name, isModule := strings.CutSuffix(entry.Name(), ".py"); isModule && !entry.IsDir() {
+			modules = append(modules, name)
+		} else if entry.IsDir() && len(python.ResolveModulePath(cd.FS, dir, entry.Name())) != 0 {
+			modules = append(modules, entry.Name())
+		}
+	}
+
+	sort.Strings(modules)
+	return modules
+}
+
+// packageName returns the name of the package which provides the module
+func packageName(module string) string {
+	topLevel := strings.Split(module, ".")[0]
+	if name, ok := packageNames[topLevel]; ok {
+		return name
+	}
+	return topLevel
+}
+
+// isStdlibModule checks if the module is part of the standard library
+func isStdlibModule(module string) bool {
+	topLevel := strings.Split(module, ".")[0]
+	for _, name := range stdlibModules {
+		if name == topLevel {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+package python
+
+import (
+	lib "github.com/nedpals/errgoengine"
+)
+
+// built-in functions which are commonly replaced by variables with the same name
+var builtinFunctions = map[string]bool{
+	"list":  true,
+	"dict":  true,
+	"str":   true,
+	"int":   true,
+	"float": true,
+	"len":   true,
+	"sum":   true,
+	"max":   true,
+	"min":   true,
+	"input": true,
+	"print": true,
+	"type":  true,
+	"range": true,
+}
+
+type notCallableErrorCtx struct {
+	call     lib.SyntaxNode
+	function lib.SyntaxNode
+	// the assignment which replaces the built-in function of the same name
+	shadowing lib.SyntaxNode
+}
+
+var NotCallableError = lib.ErrorTemplate{
+	Name:    "NotCallableError",
+	Pattern: `TypeError: '(?P<type>\S+)' object is not callable`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		nCtx := notCallableErrorCtx{}
+		for _, call := range nodesOnErrorLine(m, "call", `(call) @call`) {
+			function := call.ChildByFieldName("function")
+			if matchesType(cd, m.Document, function, cd.Variables["type"]) {
+				nCtx.call, nCtx.function = call, function
+				m.Nearest = call
+				break
+			}
+		}
+
+		if !nCtx.call.IsNull() && nCtx.function.Type() == "identifier" && builtinFunctions[nCtx.function.Text()] {
+			nCtx.shadowing = findAssignment(cd, m.Document, nCtx.function)
+		}
+
+		m.Context = nCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(notCallableErrorCtx)
+		valueType := cd.Variables["type"]
+		gen.Add("This error occurs when parentheses are put after a value which is not a function, such as %s.", withArticle(valueType))
+		if ctx.call.IsNull() {
+			return
+		}
+
+		switch {
+		case !ctx.shadowing.IsNull():
+			gen.Add(
+				" `%s` is a built-in function, but it is replaced by a variable with the same name on line %d. The built-in function cannot be used after that.",
+				ctx.function.Text(), ctx.shadowing.StartPosition().Line+1,
+			)
+		case isNumberLiteral(ctx.function):
+			gen.Add(" Unlike in math, a number followed by parentheses does not multiply them in Python.")
+		case valueType == "NoneType":
+			explainNoneOrigin(gen, ctx.function, findValueOrigin(cd, cd.MainError.Document, ctx.function))
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(notCallableErrorCtx)
+		if ctx.call.IsNull() {
+			return
+		}
+
+		doc := cd.MainError.Document
+		switch {
+		case !ctx.shadowing.IsNull():
+			name := ctx.function.Text()
+			newName := "my_" + name
+			gen.Add("Rename the variable", func(s *lib.BugFixSuggestion) {
+				step := s.AddStep("Give the variable on line %d a different name so that `%s` still refers to the built-in function.", ctx.shadowing.StartPosition().Line+1, name)
+				lastLine := ctx.shadowing.StartPosition().Line
+				for q := enclosingScope(ctx.shadowing).Query(`((identifier) @name (#eq? @name "%s"))`, name); q.Next(); {
+					node := q.CurrentNode()
+					// calls are meant for the built-in function
+					if parent := node.Parent(); parent.Type() == "call" && parent.ChildByFieldName("function").StartByte() == node.StartByte() {
+						continue
+					}
+
+					// the changes on each line are shown in separate steps
+					if line := node.StartPosition().Line; line != lastLine {
+						step = s.AddStep("Use `%s` on line %d as well.", newName, line+1)
+						lastLine = line
+					}
+
+					step.AddFix(lib.FixSuggestion{
+						NewText:       newName,
+						StartPosition: node.StartPosition(),
+						EndPosition:   node.EndPosition(),
+					})
+				}
+			})
+		case isNumberLiteral(ctx.function):
+			gen.Add("Add the multiplication operator", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Use `*` to multiply `%s` with the value inside the parentheses.", ctx.function.Text()).
+					AddFix(lib.FixSuggestion{
+						NewText:       " * ",
+						StartPosition: ctx.function.EndPosition(),
+						EndPosition:   ctx.function.EndPosition(),
+					})
+			})
+		case cd.Variables["type"] == "NoneType":
+			addNoneFixes(cd, doc, gen, ctx.function)
+		}
+	},
+}
+
+// isNumberLiteral checks if the node is a number (eg. `2` or `1.5`)
+func isNumberLiteral(node lib.SyntaxNode) bool {
+	return !node.IsNull() && (node.Type() == "integer" || node.Type() == "float")
+}
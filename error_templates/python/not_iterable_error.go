@@ -0,0 +1,66 @@
+package python
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type notIterableErrorCtx struct {
+	// the for loop or comprehension which goes through the value
+	loop  lib.SyntaxNode
+	value lib.SyntaxNode
+}
+
+var NotIterableError = lib.ErrorTemplate{
+	Name:    "NotIterableError",
+	Pattern: `TypeError: '(?P<type>\S+)' object is not iterable`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		nCtx := notIterableErrorCtx{}
+		for _, loop := range nodesOnErrorLine(m, "loop", `[(for_statement) (for_in_clause)] @loop`) {
+			value := loop.ChildByFieldName("right")
+			if matchesType(cd, m.Document, value, cd.Variables["type"]) {
+				nCtx.loop, nCtx.value = loop, value
+				m.Nearest = value
+				break
+			}
+		}
+
+		m.Context = nCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(notIterableErrorCtx)
+		valueType := cd.Variables["type"]
+		gen.Add("This error occurs when a loop goes through the items of a value that does not contain items, such as %s.", withArticle(valueType))
+		if ctx.loop.IsNull() {
+			return
+		}
+
+		switch valueType {
+		case "int":
+			gen.Add(" `%s` is a single number. To repeat something a number of times, the number needs to be turned into a sequence of numbers with `range()`.", ctx.value.Text())
+		case "NoneType":
+			explainNoneOrigin(gen, ctx.value, findValueOrigin(cd, cd.MainError.Document, ctx.value))
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(notIterableErrorCtx)
+		if ctx.loop.IsNull() {
+			return
+		}
+
+		switch cd.Variables["type"] {
+		case "int":
+			gen.Add("Use range()", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Wrap `%s` with `range()` to go through the numbers from 0 up to `%s`.", ctx.value.Text(), ctx.value.Text()).
+					AddFix(lib.FixSuggestion{
+						NewText:       fmt.Sprintf("range(%s)", ctx.value.Text()),
+						StartPosition: ctx.value.StartPosition(),
+						EndPosition:   ctx.value.EndPosition(),
+					})
+			})
+		case "NoneType":
+			addNoneFixes(cd, cd.MainError.Document, gen, ctx.value)
+		}
+	},
+}
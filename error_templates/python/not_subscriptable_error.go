@@ -0,0 +1,66 @@
+package python
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type notSubscriptableErrorCtx struct {
+	subscript lib.SyntaxNode
+	value     lib.SyntaxNode
+}
+
+var NotSubscriptableError = lib.ErrorTemplate{
+	Name:    "NotSubscriptableError",
+	Pattern: `TypeError: '(?P<type>\S+)' object is not subscriptable`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		nCtx := notSubscriptableErrorCtx{}
+		for _, subscript := range nodesOnErrorLine(m, "subscript", `(subscript) @subscript`) {
+			value := subscript.ChildByFieldName("value")
+			if matchesType(cd, m.Document, value, cd.Variables["type"]) {
+				nCtx.subscript, nCtx.value = subscript, value
+				m.Nearest = subscript
+				break
+			}
+		}
+
+		m.Context = nCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(notSubscriptableErrorCtx)
+		valueType := cd.Variables["type"]
+		gen.Add("This error occurs when square brackets are used to get an item from a value that does not contain items, such as %s.", withArticle(valueType))
+		if ctx.subscript.IsNull() {
+			return
+		}
+
+		switch valueType {
+		case "NoneType":
+			explainNoneOrigin(gen, ctx.value, findValueOrigin(cd, cd.MainError.Document, ctx.value))
+		case "function", "method", "builtin_function_or_method":
+			gen.Add(" `%s` is a function, which is called with parentheses instead of square brackets.", ctx.value.Text())
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(notSubscriptableErrorCtx)
+		if ctx.subscript.IsNull() {
+			return
+		}
+
+		switch cd.Variables["type"] {
+		case "NoneType":
+			addNoneFixes(cd, cd.MainError.Document, gen, ctx.value)
+		case "function", "method", "builtin_function_or_method":
+			gen.Add("Call the function instead", func(s *lib.BugFixSuggestion) {
+				index := ctx.subscript.ChildByFieldName("subscript")
+				s.AddStep("Use parentheses to call `%s` with `%s`.", ctx.value.Text(), index.Text()).
+					AddFix(lib.FixSuggestion{
+						NewText:       fmt.Sprintf("%s(%s)", ctx.value.Text(), index.Text()),
+						StartPosition: ctx.subscript.StartPosition(),
+						EndPosition:   ctx.subscript.EndPosition(),
+					})
+			})
+		}
+	},
+}
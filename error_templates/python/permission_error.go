@@ -0,0 +1,93 @@
+package python
+
+import (
+	"io/fs"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type permissionErrorCtx struct {
+	pathNode lib.SyntaxNode
+	// whether the file is opened for writing (eg. with `"w"` or `"a"`)
+	writing bool
+	// whether the path is a folder, which Windows reports as a permission error
+	isDir bool
+	files []string
+}
+
+var PermissionError = lib.ErrorTemplate{
+	Name:    "PermissionError",
+	Pattern: `PermissionError: \[Errno 13\] Permission denied: '(?P<path>[^']*)'`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		pCtx := permissionErrorCtx{}
+		path := cd.Variables["path"]
+
+		var call lib.SyntaxNode
+		call, pCtx.pathNode = findOpenedPath(m, path)
+		if !pCtx.pathNode.IsNull() {
+			m.Nearest = pCtx.pathNode
+		}
+
+		if !call.IsNull() && call.Type() == "call" {
+			mode := keywordArgument(call, "mode")
+			if arguments := call.ChildByFieldName("arguments"); mode.IsNull() && arguments.NamedChildCount() > 1 && arguments.NamedChild(1).Type() != "keyword_argument" {
+				mode = arguments.NamedChild(1)
+			}
+			pCtx.writing = strings.ContainsAny(literalValue(mode), "wax+")
+		}
+
+		if cd.FS != nil {
+			if info, err := fs.Stat(cd.FS, resolvePath(cd, path)); err == nil && info.IsDir() {
+				pCtx.isDir = true
+				pCtx.files = filesInDir(cd, resolvePath(cd, path))
+			}
+		}
+
+		m.Context = pCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(permissionErrorCtx)
+		path := cd.Variables["path"]
+		gen.Add("This error occurs when the program is not allowed to open a file.")
+
+		if ctx.isDir {
+			gen.Add(" `%s` is a folder, and `open()` can only open files.", path)
+		} else if ctx.writing {
+			gen.Add(" `%s` is opened for writing, but the file is read-only, is open in another program, or is in a folder which cannot be changed.", path)
+		} else {
+			gen.Add(" `%s` may be open in another program, or the file belongs to another user.", path)
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(permissionErrorCtx)
+		path := cd.Variables["path"]
+
+		if ctx.isDir {
+			gen.Add("Open a file inside the folder", func(s *lib.BugFixSuggestion) {
+				if len(ctx.files) == 0 || ctx.pathNode.IsNull() {
+					s.AddStep("Add the name of the file to open at the end of the path.")
+					return
+				}
+
+				s.AddStep("Add the name of the file to open at the end of the path.").
+					AddFix(lib.FixSuggestion{
+						NewText:       replaceStringValue(ctx.pathNode, strings.TrimSuffix(path, "/")+"/"+ctx.files[0]),
+						StartPosition: ctx.pathNode.StartPosition(),
+						EndPosition:   ctx.pathNode.EndPosition(),
+					})
+			})
+			return
+		}
+
+		gen.Add("Close the file in other programs", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Close `%s` in other programs which use it (eg. a spreadsheet or text editor), then run the program again.", path)
+		})
+
+		if ctx.writing {
+			gen.Add("Write to a different location", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Save the file in a folder that you are allowed to change, such as the folder of the project.")
+			})
+		}
+	},
+}
@@ -0,0 +1,148 @@
+package python
+
+import (
+	"strconv"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type positionalArgumentsErrorCtx struct {
+	call       lib.SyntaxNode
+	definition lib.SyntaxNode
+	// the method does not have a parameter for the object (eg. `self`)
+	missingSelf bool
+	// the positional arguments which are not accepted by the function
+	extraArguments []lib.SyntaxNode
+}
+
+var PositionalArgumentsError = lib.ErrorTemplate{
+	Name:    "PositionalArgumentsError",
+	Pattern: `TypeError: (?P<function>\S+)\(\) takes (?P<expected>\d+) positional arguments? but (?P<given>\d+) (?:was|were) given`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		pCtx := positionalArgumentsErrorCtx{}
+		function := cd.Variables["function"]
+		expected, _ := strconv.Atoi(cd.Variables["expected"])
+		given, _ := strconv.Atoi(cd.Variables["given"])
+
+		for _, call := range callsOnErrorLine(m, function) {
+			pCtx.call = call
+			m.Nearest = call
+			break
+		}
+
+		if pCtx.call.IsNull() {
+			m.Context = pCtx
+			return
+		}
+
+		pCtx.definition = findFunction(cd, m.Document, function, int(pCtx.call.StartByte()))
+		isMethod := pCtx.call.ChildByFieldName("function").Type() == "attribute" && isInClass(pCtx.definition)
+		if isMethod {
+			// the object is given as the first argument of the method
+			given--
+			if params := pCtx.definition.ChildByFieldName("parameters"); params.NamedChildCount() == 0 || params.NamedChild(0).Text() != "self" {
+				pCtx.missingSelf = given == expected
+			}
+		}
+
+		if !pCtx.missingSelf {
+			arguments := positionalArguments(pCtx.call)
+			if isMethod {
+				expected--
+			}
+
+			if expected >= 0 && expected < len(arguments) {
+				pCtx.extraArguments = arguments[expected:]
+				m.Nearest = pCtx.extraArguments[0]
+			}
+		}
+
+		m.Context = pCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(positionalArgumentsErrorCtx)
+		gen.Add("This error occurs when a function is called with more arguments than it has parameters for.")
+		if ctx.missingSelf {
+			gen.Add(
+				" Methods are given the object they are called on as their first argument, so `%s` needs a parameter for it, which is usually named `self`.",
+				ctx.definition.ChildByFieldName("name").Text(),
+			)
+		} else if len(ctx.extraArguments) != 0 {
+			accepted := len(positionalArguments(ctx.call)) - len(ctx.extraArguments)
+			switch accepted {
+			case 0:
+				gen.Add(" `%s` does not accept any arguments, but it is given %d.", cd.Variables["function"], len(ctx.extraArguments))
+			case 1:
+				gen.Add(" `%s` only accepts 1 argument, but it is given %d.", cd.Variables["function"], accepted+len(ctx.extraArguments))
+			default:
+				gen.Add(" `%s` only accepts %d arguments, but it is given %d.", cd.Variables["function"], accepted, accepted+len(ctx.extraArguments))
+			}
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(positionalArgumentsErrorCtx)
+		if ctx.missingSelf {
+			gen.Add("Add the self parameter", func(s *lib.BugFixSuggestion) {
+				params := ctx.definition.ChildByFieldName("parameters")
+				newText := "self"
+				if params.NamedChildCount() != 0 {
+					newText += ", "
+				}
+
+				s.AddStep("Add `self` as the first parameter of `%s`.", ctx.definition.ChildByFieldName("name").Text()).
+					AddFix(lib.FixSuggestion{
+						NewText:       newText,
+						StartPosition: params.StartPosition().Add(lib.Position{Column: 1, Index: 1}),
+						EndPosition:   params.StartPosition().Add(lib.Position{Column: 1, Index: 1}),
+					})
+			})
+			return
+		}
+
+		if len(ctx.extraArguments) == 0 {
+			return
+		}
+
+		gen.Add("Remove the extra arguments", func(s *lib.BugFixSuggestion) {
+			arguments := positionalArguments(ctx.call)
+			last := ctx.extraArguments[len(ctx.extraArguments)-1]
+			start := ctx.extraArguments[0].StartPosition()
+			// remove the comma before the extra arguments as well
+			if len(arguments) > len(ctx.extraArguments) {
+				start = arguments[len(arguments)-len(ctx.extraArguments)-1].EndPosition()
+			}
+
+			s.AddStep("Remove the arguments which `%s` does not have parameters for.", cd.Variables["function"]).
+				AddFix(lib.FixSuggestion{
+					NewText:       "",
+					StartPosition: start,
+					EndPosition:   last.EndPosition(),
+				})
+		})
+	},
+}
+
+// isInClass checks if the function is defined inside a class
+func isInClass(function lib.SyntaxNode) bool {
+	if function.IsNull() {
+		return false
+	}
+
+	parent := function.Parent()
+	if parent.Type() == "decorated_definition" {
+		parent = parent.Parent()
+	}
+	return parent.Type() == "block" && parent.Parent().Type() == "class_definition"
+}
+
+// positionalArguments returns the arguments of the call which are not keyword arguments
+func positionalArguments(call lib.SyntaxNode) []lib.SyntaxNode {
+	arguments := []lib.SyntaxNode{}
+	argList := call.ChildByFieldName("arguments")
+	for i := 0; i < int(argList.NamedChildCount()); i++ {
+		if arg := argList.NamedChild(i); arg.Type() != "keyword_argument" && arg.Type() != "comment" {
+			arguments = append(arguments, arg)
+		}
+	}
+	return arguments
+}
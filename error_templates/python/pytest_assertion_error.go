@@ -0,0 +1,84 @@
+package python
+
+import (
+	"regexp"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+// the location lines of the failure report of pytest (eg. `test_calc.py:5: AssertionError`)
+// along with the code and the `E` lines before them
+const pytestStackTracePattern = `(?:(?:[ >E_].*)?\n)*?(?P<path>\S+\.py):(?P<position>\d+): ?`
+
+// the comparison which pytest shows after evaluating the assertion (eg. `E       assert -1 == 5`)
+var pytestComparisonRegex = regexp.MustCompile(`(?m)^E\s+(?:AssertionError: )?assert (?P<left>.+?) (?P<operator>==|!=|<=|>=|<|>|not in|in|is not|is) (?P<right>.+)$`)
+
+// the first difference between two sequences (eg. `E         At index 1 diff: 2 != 3`)
+var pytestDiffIndexRegex = regexp.MustCompile(`(?m)^E\s+At index (?P<index>\d+) diff: (?P<left>.+?) != (?P<right>.+)$`)
+
+// the message of the assert methods of unittest when the tests are run with pytest
+var pytestUnittestMessageRegex = regexp.MustCompile(`(?m)^E\s+AssertionError: (?P<message>.+)$`)
+
+var PytestAssertionError = lib.ErrorTemplate{
+	Name:              "PytestAssertionError",
+	Pattern:           lib.CustomErrorPattern(`_+ (?P<testName>\S+) _+\n$stacktraceAssertionError`),
+	StackTracePattern: pytestStackTracePattern,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		// tests of unittest classes are named with their class (eg. `TestCalc.test_add`)
+		testName := cd.Variables["testName"]
+		if idx := strings.LastIndex(testName, "."); idx != -1 {
+			testName = testName[idx+1:]
+		}
+
+		aCtx := newAssertionErrorCtx(cd, m, testName)
+		report := cd.Variables["stacktrace"]
+		if submatches := pytestComparisonRegex.FindStringSubmatch(report); submatches != nil && submatches[2] == aCtx.operator {
+			aCtx.setValues(submatches[1], submatches[3])
+		} else if submatches := pytestUnittestMessageRegex.FindStringSubmatch(report); submatches != nil && aCtx.operator == "==" {
+			if values := unittestEqualMessageRegex.FindStringSubmatch(submatches[1]); values != nil {
+				aCtx.setValues(values[1], values[2])
+			}
+		}
+
+		if submatches := pytestDiffIndexRegex.FindStringSubmatch(report); submatches != nil {
+			aCtx.diffIndex = submatches[1]
+			aCtx.diffValues = [2]string{submatches[2], submatches[3]}
+			if aCtx.swapped {
+				aCtx.diffValues = [2]string{submatches[3], submatches[2]}
+			}
+		}
+
+		aCtx.closeNumbers = areCloseNumbers(aCtx.actualValue, aCtx.expectedValue)
+		m.Context = aCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		explainAssertion(gen, cd.MainError.Context.(assertionErrorCtx))
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(assertionErrorCtx)
+		if ctx.closeNumbers && !ctx.expected.IsNull() && ctx.assertion.Type() == "assert_statement" {
+			gen.Add("Use pytest.approx", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Compare the numbers with `pytest.approx` which ignores the tiny difference caused by rounding.").
+					AddFix(lib.FixSuggestion{
+						NewText:       "pytest.approx(" + ctx.expected.Text() + ")",
+						StartPosition: ctx.expected.StartPosition(),
+						EndPosition:   ctx.expected.EndPosition(),
+					})
+
+				for q := cd.MainError.Document.RootNode().Query(`(import_statement name: (dotted_name) @name (#eq? @name "pytest"))`); q.Next(); {
+					return
+				}
+
+				s.AddStep("Import the `pytest` module at the top of the file.").
+					AddFix(lib.FixSuggestion{
+						NewText:       "import pytest\n",
+						StartPosition: lib.Position{Line: 0},
+						EndPosition:   lib.Position{Line: 0},
+					})
+			})
+		}
+
+		addAssertionFixes(gen, ctx)
+	},
+}
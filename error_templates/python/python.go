@@ -1,6 +1,9 @@
 package python
 
 import (
+	"fmt"
+	"strings"
+
 	lib "github.com/nedpals/errgoengine"
 	"github.com/nedpals/errgoengine/languages/python"
 )
@@ -11,12 +14,408 @@ func LoadErrorTemplates(errorTemplates *lib.ErrorTemplates) {
 	errorTemplates.MustAdd(python.Language, NameError)
 	errorTemplates.MustAdd(python.Language, ValueError)
 	errorTemplates.MustAdd(python.Language, AttributeError)
+	errorTemplates.MustAdd(python.Language, ModuleAttributeError)
+	errorTemplates.MustAdd(python.Language, UnsupportedOperandError)
+	errorTemplates.MustAdd(python.Language, ConcatenationError)
+	errorTemplates.MustAdd(python.Language, NotSubscriptableError)
+	errorTemplates.MustAdd(python.Language, NotCallableError)
+	errorTemplates.MustAdd(python.Language, NotIterableError)
+	errorTemplates.MustAdd(python.Language, PositionalArgumentsError)
+	errorTemplates.MustAdd(python.Language, MissingArgumentError)
+	errorTemplates.MustAdd(python.Language, IndexError)
+	errorTemplates.MustAdd(python.Language, KeyError)
+	errorTemplates.MustAdd(python.Language, ModuleNotFoundError)
+	errorTemplates.MustAdd(python.Language, ImportError)
+	errorTemplates.MustAdd(python.Language, UnboundLocalError)
+	errorTemplates.MustAdd(python.Language, RecursionError)
+	errorTemplates.MustAdd(python.Language, KeyboardInterrupt)
+	errorTemplates.MustAdd(python.Language, FileNotFoundError)
+	errorTemplates.MustAdd(python.Language, IsADirectoryError)
+	errorTemplates.MustAdd(python.Language, PermissionError)
+	errorTemplates.MustAdd(python.Language, UnicodeDecodeError)
+	errorTemplates.MustAdd(python.Language, AssertionError)
+	errorTemplates.MustAdd(python.Language, PytestAssertionError)
 
 	// Compile time error
 	errorTemplates.MustAdd(python.Language, SyntaxError)
+	errorTemplates.MustAdd(python.Language, GlobalDeclarationError)
+	errorTemplates.MustAdd(python.Language, MissingColonError)
+	errorTemplates.MustAdd(python.Language, MissingCommaError)
+	errorTemplates.MustAdd(python.Language, UnterminatedStringError)
+	errorTemplates.MustAdd(python.Language, UnmatchedBracketError)
+	errorTemplates.MustAdd(python.Language, InvalidAssignmentError)
+	errorTemplates.MustAdd(python.Language, AssignmentInConditionError)
+	errorTemplates.MustAdd(python.Language, FStringError)
+	errorTemplates.MustAdd(python.Language, MissingPrintParenthesesError)
 	errorTemplates.MustAdd(python.Language, IndentationError)
+	errorTemplates.MustAdd(python.Language, TabError)
 }
 
 func compileTimeError(pattern string) string {
 	return lib.CustomErrorPattern("$stacktrace" + pattern)
 }
+
+// nodesOnErrorLine returns the nodes with the given tag captured by the query
+// which start at the line of the error
+func nodesOnErrorLine(m *lib.MainError, tag string, query string, d ...any) []lib.SyntaxNode {
+	errorLine := m.ErrorNode.StartPos.Line - 1
+	nodes := []lib.SyntaxNode{}
+	for q := m.Document.RootNode().Query(query, d...); q.Next(); {
+		if node := q.CurrentNode(); q.CurrentTagName() == tag && node.StartPosition().Line == errorLine {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// enclosingScope returns the function or module containing the node
+func enclosingScope(node lib.SyntaxNode) lib.SyntaxNode {
+	scope := node.Parent()
+	for !scope.IsNull() && scope.Type() != "function_definition" && scope.Type() != "module" {
+		scope = scope.Parent()
+	}
+	return scope
+}
+
+// findAssignment returns the last assignment of the variable before the node.
+// The symbol of the variable only points to the latest assignment in its scope
+// so other assignments are looked up if it comes after the node. Assignments
+// which contain the node (eg. `x = x + 1`) are skipped since the value of the
+// variable comes from the assignment before them.
+func findAssignment(cd *lib.ContextData, doc *lib.Document, variable lib.SyntaxNode) lib.SyntaxNode {
+	pos := int(variable.StartByte())
+	if sym, ok := cd.FindSymbol(variable.Text(), pos).(*lib.AssignmentSymbol); ok && sym.Location().StartPos.Index < pos {
+		if name := doc.RootNode().NamedDescendantForPointRange(sym.Location()); name.Parent().Type() == "assignment" && int(name.Parent().EndByte()) <= pos {
+			return name.Parent()
+		}
+	}
+
+	found := lib.SyntaxNode{}
+	for q := enclosingScope(variable).Query(`(assignment left: (identifier) @name (#eq? @name "%s")) @assignment`, variable.Text()); q.Next(); {
+		if node := q.CurrentNode(); q.CurrentTagName() == "assignment" && int(node.EndByte()) <= pos {
+			found = node
+		}
+	}
+	return found
+}
+
+// findFunction returns the definition of the function or method (eg. `Dog.bark`)
+func findFunction(cd *lib.ContextData, doc *lib.Document, name string, pos int) lib.SyntaxNode {
+	var sym lib.Symbol
+	if className, methodName, isMethod := strings.Cut(name, "."); isMethod {
+		sym = lib.GetFromSymbol(lib.CastChildrenSymbol(cd.FindSymbol(className, -1)), methodName)
+	} else {
+		sym = cd.FindSymbol(name, pos)
+	}
+
+	if sym == nil || sym.Kind() != lib.SymbolKindFunction {
+		return lib.SyntaxNode{}
+	}
+
+	node := doc.RootNode().NamedDescendantForPointRange(sym.Location())
+	for !node.IsNull() && node.Type() != "function_definition" {
+		node = node.Parent()
+	}
+	return node
+}
+
+// methods of the built-in types which change the value in place and return `None`
+var inPlaceMethods = map[string]string{
+	"sort":    "sorted(%s)",
+	"reverse": "list(reversed(%s))",
+	"append":  "",
+	"extend":  "",
+	"insert":  "",
+	"remove":  "",
+	"clear":   "",
+	"update":  "",
+}
+
+type valueOrigin struct {
+	// the assignment which gives the variable its value, if the value comes from a variable
+	assignment lib.SyntaxNode
+	value      lib.SyntaxNode
+	// the definition of the function called to get the value, if any
+	function lib.SyntaxNode
+}
+
+// findValueOrigin returns where the value of the expression comes from. Variables
+// are followed to their last assignment before the expression.
+func findValueOrigin(cd *lib.ContextData, doc *lib.Document, expr lib.SyntaxNode) valueOrigin {
+	origin := valueOrigin{value: expr}
+	if expr.Type() == "identifier" {
+		// parameters and loop variables do not have an assignment
+		origin.assignment = findAssignment(cd, doc, expr)
+		if origin.assignment.IsNull() {
+			return valueOrigin{}
+		}
+		origin.value = origin.assignment.ChildByFieldName("right")
+	}
+
+	if !origin.value.IsNull() && origin.value.Type() == "call" {
+		function := origin.value.ChildByFieldName("function")
+		if function.Type() == "identifier" {
+			origin.function = findFunction(cd, doc, function.Text(), int(function.StartByte()))
+		}
+	}
+	return origin
+}
+
+// calledMethod returns the name of the method called to get the value (eg. `sort` in `items.sort()`)
+func (origin valueOrigin) calledMethod() string {
+	if origin.value.IsNull() || origin.value.Type() != "call" {
+		return ""
+	}
+
+	if function := origin.value.ChildByFieldName("function"); function.Type() == "attribute" {
+		return function.ChildByFieldName("attribute").Text()
+	}
+	return ""
+}
+
+// explainNoneOrigin explains why the value of the expression is `None`
+func explainNoneOrigin(gen *lib.ExplainGenerator, expr lib.SyntaxNode, origin valueOrigin) {
+	if origin.value.IsNull() {
+		return
+	}
+
+	if origin.value.Type() == "none" {
+		gen.Add(" `%s` is set to `None` on line %d.", expr.Text(), origin.value.StartPosition().Line+1)
+		return
+	}
+
+	reason := ""
+	if method := origin.calledMethod(); len(method) != 0 {
+		if _, ok := inPlaceMethods[method]; ok {
+			reason = fmt.Sprintf("`%s` changes the value in place and returns `None`", method)
+		}
+	} else if !origin.function.IsNull() {
+		name := origin.function.ChildByFieldName("name").Text()
+		reason = fmt.Sprintf("`%s` returns `None` when none of its `return` statements are reached", name)
+		if !hasReturnValue(origin.function) {
+			reason = fmt.Sprintf("`%s` does not return a value", name)
+		}
+	} else if origin.value.Type() == "call" && origin.value.ChildByFieldName("function").Text() == "print" {
+		reason = "`print` only displays text and returns `None`"
+	}
+
+	if len(reason) == 0 {
+		return
+	}
+
+	if !origin.assignment.IsNull() {
+		gen.Add(" `%s` is `None` because it is given the result of `%s` on line %d, and %s.", expr.Text(), origin.value.Text(), origin.value.StartPosition().Line+1, reason)
+		return
+	}
+	gen.Add(" `%s` is `None` because %s.", expr.Text(), reason)
+}
+
+// hasReturnValue checks if the function returns a value in any of its return statements
+func hasReturnValue(function lib.SyntaxNode) bool {
+	for q := function.ChildByFieldName("body").Query(`(return_statement (_)) @return`); q.Next(); {
+		// returns of nested functions do not count
+		if enclosingScope(q.CurrentNode()).StartByte() == function.StartByte() {
+			return true
+		}
+	}
+	return false
+}
+
+// addNoneCheckFix suggests running the statement only if the value is not `None`
+func addNoneCheckFix(doc *lib.Document, gen *lib.BugFixGenerator, value lib.SyntaxNode) {
+	if value.Type() != "identifier" {
+		return
+	}
+
+	addGuardFix(doc, gen, value, "Check if the value is None", fmt.Sprintf("%s is not None", value.Text()), "Only use `%s` if it is not `None`.", value.Text())
+}
+
+// addGuardFix suggests running the single-line statement containing the node
+// only if the condition is true
+func addGuardFix(doc *lib.Document, gen *lib.BugFixGenerator, node lib.SyntaxNode, title string, condition string, step string, d ...any) {
+	statement := node
+	for !statement.IsNull() && !strings.HasSuffix(statement.Type(), "_statement") {
+		statement = statement.Parent()
+	}
+
+	if statement.IsNull() || statement.StartPosition().Line != statement.EndPosition().Line {
+		return
+	}
+
+	gen.Add(title, func(s *lib.BugFixSuggestion) {
+		line := statement.StartPosition().Line
+		spaces := doc.LineAt(line)[:statement.StartPosition().Column]
+		s.AddStep(step, d...).
+			AddFix(lib.FixSuggestion{
+				NewText:       fmt.Sprintf("%sif %s:\n", spaces, condition),
+				StartPosition: lib.Position{Line: line},
+				EndPosition:   lib.Position{Line: line},
+			}).
+			AddFix(lib.FixSuggestion{
+				NewText:       "    ",
+				StartPosition: lib.Position{Line: line},
+				EndPosition:   lib.Position{Line: line},
+			})
+	})
+}
+
+// built-in functions whose return type is always the same
+var builtinReturnTypes = map[string]string{
+	"input":  "str",
+	"str":    "str",
+	"int":    "int",
+	"float":  "float",
+	"len":    "int",
+	"list":   "list",
+	"sorted": "list",
+	"dict":   "dict",
+	"print":  "NoneType",
+}
+
+// the number of variables which guessType follows to their assignments
+const maxGuessTypeDepth = 16
+
+// guessType returns the name of the type of the expression as shown in error
+// messages (eg. `str`) or an empty string if it is not known
+func guessType(cd *lib.ContextData, doc *lib.Document, node lib.SyntaxNode) string {
+	return guessTypeWithDepth(cd, doc, node, 0)
+}
+
+func guessTypeWithDepth(cd *lib.ContextData, doc *lib.Document, node lib.SyntaxNode, depth int) string {
+	if node.IsNull() || depth > maxGuessTypeDepth {
+		return ""
+	}
+
+	switch node.Type() {
+	case "string", "concatenated_string":
+		return "str"
+	case "integer":
+		return "int"
+	case "float":
+		return "float"
+	case "true", "false":
+		return "bool"
+	case "none":
+		return "NoneType"
+	case "list", "list_comprehension":
+		return "list"
+	case "dictionary", "dictionary_comprehension":
+		return "dict"
+	case "tuple":
+		return "tuple"
+	case "parenthesized_expression":
+		return guessTypeWithDepth(cd, doc, node.NamedChild(0), depth)
+	case "call":
+		if function := node.ChildByFieldName("function"); function.Type() == "identifier" {
+			return builtinReturnTypes[function.Text()]
+		}
+	case "identifier":
+		if assignment := findAssignment(cd, doc, node); !assignment.IsNull() {
+			return guessTypeWithDepth(cd, doc, assignment.ChildByFieldName("right"), depth+1)
+		}
+	}
+	return ""
+}
+
+// isInputCall checks if the value is read with `input()`
+func isInputCall(node lib.SyntaxNode) bool {
+	return !node.IsNull() && node.Type() == "call" && node.ChildByFieldName("function").Text() == "input"
+}
+
+// withArticle adds "a" or "an" before the type name (eg. "an `int`")
+func withArticle(typeName string) string {
+	if strings.ContainsAny(typeName[:1], "aeiouAEIOU") {
+		return fmt.Sprintf("an `%s`", typeName)
+	}
+	return fmt.Sprintf("a `%s`", typeName)
+}
+
+// addNoneFixes suggests fixes for a value which is `None`. Values given by
+// methods which change the value in place are replaced with their
+// alternatives which return a new value (eg. `sorted(items)`).
+func addNoneFixes(cd *lib.ContextData, doc *lib.Document, gen *lib.BugFixGenerator, value lib.SyntaxNode) {
+	origin := findValueOrigin(cd, doc, value)
+	if method := origin.calledMethod(); len(inPlaceMethods[method]) != 0 && !origin.assignment.IsNull() {
+		object := origin.value.ChildByFieldName("function").ChildByFieldName("object")
+		newValue := fmt.Sprintf(inPlaceMethods[method], object.Text())
+		gen.Add(fmt.Sprintf("Use %s instead", newValue), func(s *lib.BugFixSuggestion) {
+			s.AddStep("Use `%s`, which returns a new list instead of changing `%s` in place.", newValue, object.Text()).
+				AddFix(lib.FixSuggestion{
+					NewText:       newValue,
+					StartPosition: origin.value.StartPosition(),
+					EndPosition:   origin.value.EndPosition(),
+				})
+		})
+		return
+	}
+
+	addNoneCheckFix(doc, gen, value)
+}
+
+// addReturnValueFix suggests returning the value from the function which
+// gives `None` because it does not have a `return` statement. Values which are
+// only printed at the end of the function (eg. `print(total)`) are returned instead.
+func addReturnValueFix(doc *lib.Document, gen *lib.BugFixGenerator, origin valueOrigin) {
+	if origin.function.IsNull() || hasReturnValue(origin.function) {
+		return
+	}
+
+	name := origin.function.ChildByFieldName("name").Text()
+	gen.Add(fmt.Sprintf("Return a value from %s", name), func(s *lib.BugFixSuggestion) {
+		last := origin.function.ChildByFieldName("body").LastNamedChild()
+		if last.Type() == "expression_statement" && last.NamedChild(0).Type() == "call" {
+			call := last.NamedChild(0)
+			if arguments := call.ChildByFieldName("arguments"); call.ChildByFieldName("function").Text() == "print" && arguments.NamedChildCount() == 1 {
+				value := arguments.NamedChild(0)
+				s.AddStep("Return `%s` instead of printing it so that the code which calls `%s` gets the value.", value.Text(), name).
+					AddFix(lib.FixSuggestion{
+						NewText:       "return ",
+						StartPosition: call.StartPosition(),
+						EndPosition:   value.StartPosition(),
+					}).
+					AddFix(lib.FixSuggestion{
+						NewText:       "",
+						StartPosition: value.EndPosition(),
+						EndPosition:   call.EndPosition(),
+					})
+				return
+			}
+		}
+
+		s.AddStep("Add a `return` statement at the end of `%s` with the value that it should give back.", name)
+	})
+}
+
+// callsOnErrorLine returns the calls to the function or method (eg. `Dog.bark`) on the line of the error
+func callsOnErrorLine(m *lib.MainError, name string) []lib.SyntaxNode {
+	name = name[strings.LastIndex(name, ".")+1:]
+	calls := []lib.SyntaxNode{}
+	for _, call := range nodesOnErrorLine(m, "call", `(call) @call`) {
+		function := call.ChildByFieldName("function")
+		if function.Type() == "attribute" {
+			function = function.ChildByFieldName("attribute")
+		}
+
+		if function.Text() == name {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// lastNodeOnLine returns the last descendant of the node which ends on the
+// line. Nodes which go past the line are looked into for their children.
+func lastNodeOnLine(node lib.SyntaxNode, line int) lib.SyntaxNode {
+	for i := int(node.ChildCount()) - 1; i >= 0; i-- {
+		child := node.Child(i)
+		if child.StartPosition().Line > line {
+			continue
+		} else if child.EndPosition().Line == line {
+			return child
+		} else if child.EndPosition().Line > line {
+			return lastNodeOnLine(child, line)
+		}
+		break
+	}
+	return lib.SyntaxNode{}
+}
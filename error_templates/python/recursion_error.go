@@ -0,0 +1,275 @@
+package python
+
+import (
+	"fmt"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type recursionProblemKind int
+
+const (
+	recursionUnknown recursionProblemKind = iota
+	recursionWithoutBaseCase
+	recursionWithSameArguments
+	recursionAwayFromBaseCase
+)
+
+type recursionErrorCtx struct {
+	kind         recursionProblemKind
+	functionName string
+	function     lib.SyntaxNode
+	call         lib.SyntaxNode
+	// the argument of the recursive call passed to the parameter checked by the base case
+	argument  lib.SyntaxNode
+	parameter string
+	// whether the base case is reached by decreasing the parameter (eg. `n == 0` or `n <= 1`)
+	decreasing bool
+}
+
+var RecursionError = lib.ErrorTemplate{
+	Name:    "RecursionError",
+	Pattern: `RecursionError: maximum recursion depth exceeded(?: .+)?`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		rCtx := recursionErrorCtx{decreasing: true}
+		cycle := recursiveFrames(cd.TraceStack)
+		if len(cycle) == 0 {
+			m.Context = rCtx
+			return
+		}
+
+		// the first frame of the cycle points to the recursive call while the
+		// called function is found in the last frame of the cycle
+		frame := cycle[0]
+		rCtx.functionName = frame.SymbolName
+		calleeName := cycle[len(cycle)-1].SymbolName
+		rootNode := m.Document.RootNode()
+
+		for q := rootNode.Query(`(function_definition name: (identifier) @name (#eq? @name "%s")) @function`, rCtx.functionName); q.Next(); {
+			if q.CurrentTagName() != "function" {
+				continue
+			}
+
+			node := q.CurrentNode()
+			if frame.StartPos.Line >= node.StartPosition().Line+1 && frame.StartPos.Line <= node.EndPosition().Line+1 {
+				rCtx.function = node
+				break
+			}
+		}
+
+		if rCtx.function.IsNull() {
+			m.Context = rCtx
+			return
+		}
+
+		for q := rCtx.function.Query(`(call function: (identifier) @name (#eq? @name "%s")) @call`, calleeName); q.Next(); {
+			if q.CurrentTagName() != "call" {
+				continue
+			}
+
+			node := q.CurrentNode()
+			if node.StartPosition().Line+1 == frame.StartPos.Line {
+				rCtx.call = node
+				m.Nearest = node
+				break
+			}
+		}
+
+		// look for an if statement which returns without recursing
+		var baseCase lib.SyntaxNode
+		for q := rCtx.function.Query(`(if_statement consequence: (block) @consequence) @if`); q.Next() && baseCase.IsNull(); {
+			if q.CurrentTagName() != "consequence" {
+				continue
+			}
+
+			consequence := q.CurrentNode()
+			for rq := consequence.Query(`(return_statement) @return`); rq.Next(); {
+				if !strings.Contains(rq.CurrentNode().Text(), calleeName+"(") {
+					baseCase = consequence.Parent()
+					break
+				}
+			}
+		}
+
+		if baseCase.IsNull() {
+			rCtx.kind = recursionWithoutBaseCase
+			m.Context = rCtx
+			return
+		} else if rCtx.call.IsNull() {
+			m.Context = rCtx
+			return
+		}
+
+		// find the parameter checked by the base case (eg. `n` in `n == 0`)
+		parameters := rCtx.function.ChildByFieldName("parameters")
+		paramIdx := 0
+		for q := baseCase.ChildByFieldName("condition").Query(`(comparison_operator . (identifier) @left) @comparison`); q.Next(); {
+			if q.CurrentTagName() == "comparison" {
+				operator := q.CurrentNode().Child(1).Type()
+				rCtx.decreasing = operator == "==" || operator == "<" || operator == "<="
+				continue
+			}
+
+			for i := 0; i < int(parameters.NamedChildCount()); i++ {
+				if parameterName(parameters.NamedChild(i)) == q.CurrentNode().Text() {
+					paramIdx = i
+				}
+			}
+			break
+		}
+
+		arguments := rCtx.call.ChildByFieldName("arguments")
+		if paramIdx >= int(parameters.NamedChildCount()) || paramIdx >= int(arguments.NamedChildCount()) {
+			m.Context = rCtx
+			return
+		}
+
+		rCtx.parameter = parameterName(parameters.NamedChild(paramIdx))
+		rCtx.argument = arguments.NamedChild(paramIdx)
+
+		if rCtx.argument.Text() == rCtx.parameter {
+			rCtx.kind = recursionWithSameArguments
+		} else if rCtx.argument.Type() == "binary_operator" && rCtx.argument.ChildByFieldName("left").Text() == rCtx.parameter {
+			operator := rCtx.argument.ChildByFieldName("operator").Type()
+			if (rCtx.decreasing && operator == "+") || (!rCtx.decreasing && operator == "-") {
+				rCtx.kind = recursionAwayFromBaseCase
+			}
+		}
+
+		m.Context = rCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(recursionErrorCtx)
+		gen.Add("This error occurs when a function keeps calling itself until Python reaches the limit of how many calls can be running at the same time.")
+
+		switch ctx.kind {
+		case recursionWithoutBaseCase:
+			gen.Add(" The `%s` function calls itself without a base case that stops the recursion.", ctx.functionName)
+		case recursionWithSameArguments:
+			gen.Add(" The `%s` function has a base case, but it calls itself with the same `%s` every time so the base case is never reached.", ctx.functionName, ctx.parameter)
+		case recursionAwayFromBaseCase:
+			gen.Add(" The `%s` function has a base case, but the recursive call `%s` moves `%s` away from it so the base case is never reached.", ctx.functionName, ctx.call.Text(), ctx.parameter)
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(recursionErrorCtx)
+		if ctx.function.IsNull() {
+			return
+		}
+
+		doc := cd.MainError.Document
+		direction := "decrease"
+		if !ctx.decreasing {
+			direction = "increase"
+		}
+
+		switch ctx.kind {
+		case recursionWithoutBaseCase:
+			gen.Add("Add a base case", func(s *lib.BugFixSuggestion) {
+				parameters := ctx.function.ChildByFieldName("parameters")
+				body := ctx.function.ChildByFieldName("body")
+				if parameters.NamedChildCount() == 0 || body.NamedChildCount() == 0 {
+					s.AddStep("Add a condition to the `%s` function that returns without calling `%s` again.", ctx.functionName, ctx.functionName)
+					return
+				}
+
+				parameter := parameterName(parameters.NamedChild(0))
+				firstStatement := body.FirstNamedChild()
+				spaces := doc.LineAt(firstStatement.StartPosition().Line)[:firstStatement.StartPosition().Column]
+
+				returnStatement := "return"
+				if hasReturnValue(ctx.function) {
+					returnStatement = "return 0"
+					// the result is multiplied with the value returned by the recursive call
+					if !ctx.call.IsNull() && ctx.call.Parent().Type() == "binary_operator" && ctx.call.Parent().ChildByFieldName("operator").Type() == "*" {
+						returnStatement = "return 1"
+					}
+				}
+
+				// sequences which are sliced on each call stop once they are empty
+				condition := fmt.Sprintf("%s <= 0", parameter)
+				if !ctx.call.IsNull() {
+					if arguments := ctx.call.ChildByFieldName("arguments"); arguments.NamedChildCount() != 0 && arguments.NamedChild(0).Type() == "subscript" {
+						condition = fmt.Sprintf("len(%s) == 0", parameter)
+					}
+				}
+
+				s.AddStep(
+					"Add a condition at the start of `%s` that returns without calling itself once `%s` reaches its simplest value.",
+					ctx.functionName, parameter,
+				).AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("if %s:\n%s    %s\n%s", condition, spaces, returnStatement, spaces),
+					StartPosition: firstStatement.StartPosition(),
+					EndPosition:   firstStatement.StartPosition(),
+					Description:   "Replace the condition and the returned value with the expected result for the simplest input.",
+				})
+			})
+		case recursionWithSameArguments:
+			gen.Add(fmt.Sprintf("Change the value of %s in the recursive call", ctx.parameter), func(s *lib.BugFixSuggestion) {
+				newArgument := fmt.Sprintf("%s - 1", ctx.parameter)
+				if !ctx.decreasing {
+					newArgument = fmt.Sprintf("%s + 1", ctx.parameter)
+				}
+
+				s.AddStep("Pass a value that gets closer to the base case each time `%s` calls itself.", ctx.functionName).
+					AddFix(lib.FixSuggestion{
+						NewText:       newArgument,
+						StartPosition: ctx.argument.StartPosition(),
+						EndPosition:   ctx.argument.EndPosition(),
+						Description:   fmt.Sprintf("This makes `%s` %s on every call until the base case is reached.", ctx.parameter, direction),
+					})
+			})
+		case recursionAwayFromBaseCase:
+			gen.Add("Make progress toward the base case", func(s *lib.BugFixSuggestion) {
+				operatorNode := ctx.argument.ChildByFieldName("operator")
+				newOperator := "-"
+				if !ctx.decreasing {
+					newOperator = "+"
+				}
+
+				s.AddStep("Change the recursive call so that `%s` gets closer to the base case instead.", ctx.parameter).
+					AddFix(lib.FixSuggestion{
+						NewText:       newOperator,
+						StartPosition: operatorNode.StartPosition(),
+						EndPosition:   operatorNode.EndPosition(),
+					})
+			})
+		default:
+			gen.Add("Check the base case", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Make sure that the arguments passed when `%s` calls itself eventually satisfy its base case.", ctx.functionName)
+			})
+		}
+	},
+}
+
+// recursiveFrames returns the frames which repeat in the stack starting from
+// the most recent call. Python lists the most recent call last, and the frames
+// after the repeated ones (eg. the line which was running when the limit was
+// reached) are skipped.
+func recursiveFrames(stack lib.TraceStack) lib.TraceStack {
+	reversed := make(lib.TraceStack, len(stack))
+	for i, frame := range stack {
+		reversed[len(stack)-1-i] = frame
+	}
+
+	for start := range reversed {
+		if cycle := reversed[start:].Cycle(); len(cycle) != 0 {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// parameterName returns the name of the parameter including the ones with
+// default values or types (eg. `n` in `n=0` or `n: int`)
+func parameterName(param lib.SyntaxNode) string {
+	if param.Type() == "identifier" {
+		return param.Text()
+	}
+
+	if name := param.ChildByFieldName("name"); !name.IsNull() {
+		return name.Text()
+	}
+	return param.NamedChild(0).Text()
+}
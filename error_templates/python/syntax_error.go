@@ -8,16 +8,40 @@ import (
 
 var charToWord = map[string]string{
 	"(": "open parenthesis",
+	")": "close parenthesis",
+	"[": "open square bracket",
+	"]": "close square bracket",
+	"{": "open curly brace",
+	"}": "close curly brace",
 }
 
 var charPairs = map[string]string{
 	"(": ")",
+	"[": "]",
+	"{": "}",
 }
 
 var SyntaxError = lib.ErrorTemplate{
 	Name:    "SyntaxError",
 	Pattern: compileTimeError("SyntaxError: '(?P<character>.+)' was never closed"),
 	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		for _, node := range nodesOnErrorLine(m, "error", `(ERROR) @error`) {
+			m.Nearest = node
+			if node.StartPosition().Line == node.EndPosition().Line {
+				return
+			}
+
+			// error nodes which include the lines after it are narrowed down
+			// to the character which is not closed
+			for i := 0; i < int(node.ChildCount()); i++ {
+				if child := node.Child(i); child.Type() == cd.Variables["character"] {
+					m.Nearest = child
+					break
+				}
+			}
+			return
+		}
+
 		for q := m.Nearest.Query(`(ERROR) @err`); q.Next(); {
 			m.Nearest = q.CurrentNode()
 			break
@@ -44,11 +68,23 @@ var SyntaxError = lib.ErrorTemplate{
 			gen.Add("Close "+text, func(s *lib.BugFixSuggestion) {
 				text = fmt.Sprintf("%s (`%s`)", text, cd.Variables["character"])
 
+				// the error node may include the lines after it so the pair is
+				// added after the last node on the line of the error instead
+				errorNode := cd.MainError.Nearest
+				if !errorNode.IsError() {
+					errorNode = errorNode.Parent()
+				}
+
+				end := errorNode.EndPosition()
+				if last := lastNodeOnLine(errorNode, cd.MainError.ErrorNode.StartPos.Line-1); !last.IsNull() {
+					end = last.EndPosition()
+				}
+
 				s.AddStep("Ensure that %s is closed properly.", text).
 					AddFix(lib.FixSuggestion{
 						NewText:       pair,
-						StartPosition: cd.MainError.Nearest.EndPosition(),
-						EndPosition:   cd.MainError.Nearest.EndPosition(),
+						StartPosition: end,
+						EndPosition:   end,
 					})
 			})
 		}
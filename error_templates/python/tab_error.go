@@ -0,0 +1,97 @@
+package python
+
+import (
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type tabErrorCtx struct {
+	line int
+	// the nearest line before it which is indented with the other kind of whitespace
+	otherLine int
+	// the first and last lines of the top-level block which has the line
+	blockStart int
+	blockEnd   int
+}
+
+var TabError = lib.ErrorTemplate{
+	Name:    "TabError",
+	Pattern: compileTimeError(`TabError: inconsistent use of tabs and spaces in indentation`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		tCtx := tabErrorCtx{line: m.ErrorNode.StartPos.Line - 1, otherLine: -1}
+		doc := m.Document
+		indentation := leadingWhitespace(doc.LineAt(tCtx.line))
+
+		tCtx.blockStart = tCtx.line
+		for tCtx.blockStart > 0 && len(leadingWhitespace(doc.LineAt(tCtx.blockStart))) != 0 {
+			tCtx.blockStart--
+		}
+
+		tCtx.blockEnd = tCtx.line
+		for i := tCtx.line + 1; i < doc.TotalLines(); i++ {
+			if line := doc.LineAt(i); len(strings.TrimSpace(line)) != 0 {
+				if len(leadingWhitespace(line)) == 0 {
+					break
+				}
+				tCtx.blockEnd = i
+			}
+		}
+
+		for i := previousCodeLine(doc, tCtx.line); i >= tCtx.blockStart; i = previousCodeLine(doc, i) {
+			if hasMixedIndentation(indentation, leadingWhitespace(doc.LineAt(i))) {
+				tCtx.otherLine = i
+				break
+			}
+		}
+
+		m.Context = tCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(tabErrorCtx)
+		doc := cd.MainError.Document
+		gen.Add("This error occurs when the indentation of a block uses both tabs and spaces. They can look the same in an editor, but Python cannot tell how many spaces a tab is meant to be.")
+
+		if ctx.otherLine != -1 {
+			gen.Add(
+				" Line %d starts with %s while line %d starts with %s.",
+				ctx.line+1, describeIndentation(leadingWhitespace(doc.LineAt(ctx.line))),
+				ctx.otherLine+1, describeIndentation(leadingWhitespace(doc.LineAt(ctx.otherLine))),
+			)
+		} else {
+			gen.Add(" Line %d starts with %s.", ctx.line+1, describeIndentation(leadingWhitespace(doc.LineAt(ctx.line))))
+		}
+		gen.Add(whitespaceLegend)
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(tabErrorCtx)
+		doc := cd.MainError.Document
+
+		// the spaces of the other lines are used for each tab if the file
+		// already uses spaces for its indentation
+		unit := indentationUnit(doc)
+		if strings.Contains(unit, "\t") {
+			unit = "    "
+		}
+
+		gen.Add("Use spaces for the indentation", func(s *lib.BugFixSuggestion) {
+			step := s.AddStep("Replace the tabs in the indentation of the block with spaces so that every line is indented the same way.")
+			for i := ctx.blockStart; i <= ctx.blockEnd; i++ {
+				indentation := leadingWhitespace(doc.LineAt(i))
+				if !strings.Contains(indentation, "\t") || len(strings.TrimSpace(doc.LineAt(i))) == 0 {
+					continue
+				}
+
+				step.AddFix(lib.FixSuggestion{
+					NewText:       strings.ReplaceAll(indentation, "\t", unit),
+					StartPosition: lib.Position{Line: i},
+					EndPosition:   lib.Position{Line: i, Column: len(indentation)},
+				})
+			}
+
+			if len(step.Fixes) != 0 {
+				step.Fixes[len(step.Fixes)-1].Description = "Most editors can also do this for the whole file with a command such as \"Convert Indentation to Spaces\"."
+			}
+		})
+	},
+}
@@ -0,0 +1,6 @@
+def add(a, b):
+    return a - b
+
+
+def average(numbers):
+    return sum(numbers) // len(numbers)
@@ -0,0 +1,15 @@
+import unittest
+
+from calc import add, average
+
+
+class TestCalc(unittest.TestCase):
+    def test_add(self):
+        self.assertEqual(add(2, 3), 5)
+
+    def test_average(self):
+        self.assertEqual(average([1, 2]), 1.5)
+
+
+if __name__ == "__main__":
+    unittest.main()
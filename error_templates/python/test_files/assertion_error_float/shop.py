@@ -0,0 +1,5 @@
+def total_price(prices):
+    total = 0
+    for price in prices:
+        total += price
+    return total
@@ -0,0 +1,12 @@
+import unittest
+
+import shop
+
+
+class TestShop(unittest.TestCase):
+    def test_total_price(self):
+        self.assertEqual(shop.total_price([0.1, 0.2]), 0.3)
+
+
+if __name__ == "__main__":
+    unittest.main()
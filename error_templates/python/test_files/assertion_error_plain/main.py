@@ -0,0 +1,6 @@
+def withdraw(balance, amount):
+    assert amount <= balance, "Not enough money"
+    return balance - amount
+
+
+print(withdraw(100, 150))
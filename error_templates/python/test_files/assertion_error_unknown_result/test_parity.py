@@ -0,0 +1,8 @@
+from parity import is_even
+
+
+def test_is_even():
+    assert is_even(4)
+
+
+test_is_even()
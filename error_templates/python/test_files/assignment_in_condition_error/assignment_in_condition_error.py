@@ -0,0 +1,3 @@
+score = 100
+if score = 100:
+    print("Perfect!")
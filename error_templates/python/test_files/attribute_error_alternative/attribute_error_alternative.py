@@ -0,0 +1,3 @@
+names = ["Ana", "Carl"]
+names.push("Bea")
+print(names)
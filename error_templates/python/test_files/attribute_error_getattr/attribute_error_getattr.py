@@ -0,0 +1,3 @@
+names = ["Ana", "Ben"]
+add = getattr(names, "push")
+add("Cole")
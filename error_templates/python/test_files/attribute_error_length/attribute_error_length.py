@@ -0,0 +1,3 @@
+word = input("Enter a word: ")
+if word.length > 5:
+    print("That is a long word")
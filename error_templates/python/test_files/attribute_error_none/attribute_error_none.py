@@ -0,0 +1,3 @@
+scores = [70, 95, 88]
+scores = scores.sort()
+scores.append(100)
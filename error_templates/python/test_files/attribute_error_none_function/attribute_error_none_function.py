@@ -0,0 +1,6 @@
+def get_name(person):
+    print(person["name"])
+
+person = {"name": "ana"}
+name = get_name(person)
+print(name.upper())
@@ -0,0 +1,4 @@
+def up(s):
+    return s.upper()
+
+up(None)
@@ -0,0 +1,4 @@
+message = "Hello"
+name = input("Name: ")
+message.append(name)
+print(message)
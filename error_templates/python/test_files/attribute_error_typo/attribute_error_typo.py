@@ -0,0 +1,10 @@
+class Box:
+    def __init__(self, width, length):
+        self.width = width
+        self.length = length
+
+    def area(self):
+        return self.width * self.length
+
+box = Box(3, 4)
+print(box.lenght)
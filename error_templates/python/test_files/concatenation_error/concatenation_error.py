@@ -0,0 +1,3 @@
+age = 21
+message = "Age: " + age
+print(message)
@@ -0,0 +1,3 @@
+numbers = [1, 2, 3]
+numbers = numbers + 4
+print(numbers)
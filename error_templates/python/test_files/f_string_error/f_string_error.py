@@ -0,0 +1,2 @@
+name = "Ana"
+print(f"Hello, {name")
@@ -0,0 +1,2 @@
+price = 5
+print(f"Price: {price} }")
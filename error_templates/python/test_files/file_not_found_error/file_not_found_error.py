@@ -0,0 +1,3 @@
+with open("data.txt") as f:
+    for line in f:
+        print(line.strip())
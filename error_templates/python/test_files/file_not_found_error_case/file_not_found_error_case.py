@@ -0,0 +1,3 @@
+scores = open("scores.txt")
+print(scores.read())
+scores.close()
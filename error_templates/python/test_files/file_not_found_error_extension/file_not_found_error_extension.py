@@ -0,0 +1,5 @@
+import csv
+
+with open("students.txt") as f:
+    for row in csv.reader(f):
+        print(row[0])
@@ -0,0 +1,5 @@
+def load_items():
+    with open("items.txt") as f:
+        return f.read().splitlines()
+
+print(load_items())
@@ -0,0 +1,5 @@
+#!/usr/bin/env python3
+"""Prints the items of the inventory."""
+
+with open("items.txt") as f:
+    print(f.read())
@@ -0,0 +1,4 @@
+from os import path
+
+with open("items.txt") as f:
+    print(f.read())
@@ -0,0 +1,10 @@
+total = 0
+
+
+def add(amount):
+    print(total)
+    global total
+    total += amount
+
+
+add(5)
@@ -0,0 +1,2 @@
+def calculate_total(prices):
+    return sum(prices)
@@ -0,0 +1,3 @@
+from billing import calculate_totals
+
+print(calculate_totals([10, 20]))
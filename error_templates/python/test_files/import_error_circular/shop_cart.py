@@ -0,0 +1,5 @@
+from shop_main import format_price
+
+
+def add_item(name, price):
+    print(name, format_price(price))
@@ -0,0 +1,8 @@
+from shop_cart import add_item
+
+
+def format_price(price):
+    return f"${price:.2f}"
+
+
+add_item("apple", 1.5)
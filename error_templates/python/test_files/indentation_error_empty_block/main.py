@@ -0,0 +1,7 @@
+class Student:
+    def __init__(self, name):
+        self.name = name
+
+    def greet(self):
+
+print(Student("Ana").name)
@@ -0,0 +1,7 @@
+def check_age(age):
+    if age >= 18:
+    print("You can vote")
+    else:
+        print("You cannot vote yet")
+
+check_age(20)
@@ -0,0 +1,6 @@
+total = 0
+for price in [3, 5, 8]:
+    total += price
+        print("Added", price)
+        print("Total so far:", total)
+print("Done")
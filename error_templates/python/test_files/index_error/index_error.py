@@ -0,0 +1,2 @@
+fruits = ["apple", "banana", "cherry"]
+print(fruits[3])
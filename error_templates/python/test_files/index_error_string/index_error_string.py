@@ -0,0 +1,3 @@
+word = "hi"
+letter = word[5]
+print(letter)
@@ -0,0 +1,2 @@
+int(age) = input("Age: ")
+print(age)
@@ -0,0 +1,2 @@
+with open("reports") as f:
+    print(f.read())
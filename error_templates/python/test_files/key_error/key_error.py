@@ -0,0 +1,2 @@
+person = {"Name": "Ana", "age": 21}
+print(person["name"])
@@ -0,0 +1,4 @@
+stock = {"apple": 3, "banana": 5}
+fruit = "cherry"
+count = stock[fruit]
+print(count)
@@ -0,0 +1,2 @@
+data = {1: "one", 2: "two", 3: "three"}
+print(data[5])
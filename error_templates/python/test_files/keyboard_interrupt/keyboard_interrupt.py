@@ -0,0 +1,3 @@
+count = 0
+while count < 5:
+    print(count)
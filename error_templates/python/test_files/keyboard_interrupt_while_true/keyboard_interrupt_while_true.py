@@ -0,0 +1,5 @@
+attempts = 0
+while True:
+    attempts += 1
+    if attempts > 3:
+        print("Too many attempts")
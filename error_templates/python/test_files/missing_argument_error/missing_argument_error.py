@@ -0,0 +1,4 @@
+def introduce(name, age):
+    print(name + " is " + str(age))
+
+introduce("Ana")
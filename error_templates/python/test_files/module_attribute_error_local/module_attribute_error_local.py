@@ -0,0 +1,3 @@
+import shapes
+
+print(shapes.sqaure_area(4))
@@ -0,0 +1,2 @@
+# notes on how random numbers work
+seed = 42
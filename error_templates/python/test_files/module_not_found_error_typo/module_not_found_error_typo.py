@@ -0,0 +1,3 @@
+from geometri import area
+
+print(area(2))
@@ -0,0 +1,2 @@
+result = 2(3 + 4)
+print(result)
@@ -0,0 +1,4 @@
+def apply(fn):
+    return fn()
+
+apply(None)
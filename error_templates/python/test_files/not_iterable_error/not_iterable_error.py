@@ -0,0 +1,3 @@
+items = ["apple", "banana"]
+for i in len(items):
+    print(items[i])
@@ -0,0 +1,4 @@
+names = ["Carl", "Ana"]
+ordered = names.sort()
+for name in ordered:
+    print(name)
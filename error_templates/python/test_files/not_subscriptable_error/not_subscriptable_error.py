@@ -0,0 +1,3 @@
+numbers = [3, 1, 2]
+sorted_numbers = numbers.sort()
+print(sorted_numbers[0])
@@ -0,0 +1,4 @@
+def first(items):
+    return items[0]
+
+print(first(None))
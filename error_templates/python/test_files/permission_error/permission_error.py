@@ -0,0 +1,2 @@
+with open("grades.csv", "w") as f:
+    f.write("name,grade\n")
@@ -0,0 +1,7 @@
+class Dog:
+    def bark():
+        print("Woof!")
+
+
+dog = Dog()
+dog.bark()
@@ -0,0 +1,5 @@
+from grades import average
+
+
+def test_average():
+    assert average([0.1, 0.2]) == 0.15
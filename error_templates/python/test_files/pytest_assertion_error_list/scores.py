@@ -0,0 +1,3 @@
+def top_three(scores):
+    ranked = sorted(scores)
+    return ranked[:3]
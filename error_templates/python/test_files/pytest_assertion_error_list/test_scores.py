@@ -0,0 +1,6 @@
+import scores
+
+
+def test_top_three():
+    result = [95, 88, 72]
+    assert scores.top_three([72, 95, 60, 88]) == result
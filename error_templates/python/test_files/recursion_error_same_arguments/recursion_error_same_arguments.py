@@ -0,0 +1,9 @@
+def countdown(n):
+    if n == 0:
+        print("Liftoff!")
+        return
+    print(n)
+    countdown(n)
+
+
+countdown(3)
@@ -0,0 +1,2 @@
+fruits = ["apple", "banana"
+print(fruits)
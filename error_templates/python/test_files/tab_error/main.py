@@ -0,0 +1,7 @@
+def average(numbers):
+    total = 0
+    for number in numbers:
+	total += number
+    return total / len(numbers)
+
+print(average([90, 85, 77]))
@@ -0,0 +1,13 @@
+def make_counter():
+    total = 0
+
+    def add(amount):
+        print(total)
+        total = total + amount
+        return total
+
+    return add
+
+
+counter = make_counter()
+counter(5)
@@ -0,0 +1,4 @@
+with open("notes.txt") as f:
+    text = f.read()
+
+print(text)
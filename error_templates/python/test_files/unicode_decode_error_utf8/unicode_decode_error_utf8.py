@@ -0,0 +1,3 @@
+notes = open("notes.txt", encoding="utf-8")
+text = notes.read()
+notes.close()
@@ -0,0 +1,4 @@
+total = 0
+amount = input("Amount: ")
+total = total + amount
+print(total)
@@ -0,0 +1,4 @@
+values = [1, 'x']
+total = 0
+for value in values:
+    total = total + value
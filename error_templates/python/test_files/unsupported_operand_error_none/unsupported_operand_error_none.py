@@ -0,0 +1,7 @@
+def get_price(item):
+    if item == "apple":
+        return 1.5
+
+price = get_price("banana")
+total = price * 2
+print(total)
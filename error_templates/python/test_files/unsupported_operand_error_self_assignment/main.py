@@ -0,0 +1,4 @@
+count = 3
+count = count
+extra = input("How many more? ")
+print(count + extra)
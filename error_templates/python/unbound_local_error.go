@@ -0,0 +1,165 @@
+package python
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type unboundLocalErrorCtx struct {
+	variable lib.SyntaxNode
+	function lib.SyntaxNode
+	// the assignment in the function which makes the variable local to it
+	assignment lib.SyntaxNode
+	// the line where the variable is defined outside of the function, -1 if it is not found
+	outerLine int
+	// `global` if the variable outside of the function is defined in the
+	// module or `nonlocal` if it is defined in an enclosing function
+	keyword string
+}
+
+var UnboundLocalError = lib.ErrorTemplate{
+	Name:    "UnboundLocalError",
+	Pattern: `UnboundLocalError: (?:cannot access )?local variable '(?P<variable>\w+)' (?:where it is not associated with a value|referenced before assignment)`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		uCtx := unboundLocalErrorCtx{outerLine: -1, keyword: "global"}
+		variable := cd.Variables["variable"]
+		for _, node := range nodesOnErrorLine(m, "name", `((identifier) @name (#eq? @name "%s"))`, variable) {
+			uCtx.variable = node
+			m.Nearest = node
+			break
+		}
+
+		if uCtx.variable.IsNull() {
+			m.Context = uCtx
+			return
+		}
+
+		uCtx.function = enclosingScope(uCtx.variable)
+		if uCtx.function.Type() != "function_definition" {
+			m.Context = uCtx
+			return
+		}
+
+		uCtx.assignment = findLocalAssignment(uCtx.function, variable)
+		if outer := enclosingScope(uCtx.function); outer.Type() == "function_definition" {
+			uCtx.keyword = "nonlocal"
+			if assignment := findLocalAssignment(outer, variable); !assignment.IsNull() {
+				uCtx.outerLine = assignment.StartPosition().Line
+			}
+		} else if tree := cd.InitOrGetSymbolTree(m.DocumentPath()).GetNearestScopedTree(int(uCtx.variable.StartByte())); tree.Parent != nil {
+			// the scope of the function only has the variables of the function
+			// so the definition outside of it is looked up from its parent
+			if sym := tree.Parent.Find(variable); sym != nil && (sym.Kind() == lib.SymbolKindAssignment || sym.Kind() == lib.SymbolKindVariable) {
+				uCtx.outerLine = sym.Location().StartPos.Line
+			}
+		}
+
+		m.Context = uCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(unboundLocalErrorCtx)
+		variable := cd.Variables["variable"]
+		gen.Add("This error occurs when a variable is used in a function before it is given a value in that function.")
+		if ctx.assignment.IsNull() {
+			return
+		}
+
+		function := ctx.function.ChildByFieldName("name").Text()
+		if line := ctx.variable.StartPosition().Line; ctx.assignment.StartPosition().Line == line {
+			gen.Add(
+				" Assigning to `%s` on line %d makes it a local variable of `%s`, but its value is read there before the local variable has one.",
+				variable, line+1, function,
+			)
+		} else {
+			gen.Add(
+				" `%s` is assigned on line %d, which makes it a local variable of `%s` in the whole function, including line %d where it is used before it has a value.",
+				variable, ctx.assignment.StartPosition().Line+1, function, line+1,
+			)
+		}
+
+		if ctx.outerLine != -1 {
+			gen.Add(" The `%s` defined outside of `%s` on line %d is not used unless it is declared with `%s`.", variable, function, ctx.outerLine+1, ctx.keyword)
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(unboundLocalErrorCtx)
+		if ctx.assignment.IsNull() || ctx.outerLine == -1 {
+			return
+		}
+
+		variable := cd.Variables["variable"]
+		function := ctx.function.ChildByFieldName("name").Text()
+		gen.Add(fmt.Sprintf("Declare the variable as %s", ctx.keyword), func(s *lib.BugFixSuggestion) {
+			body := ctx.function.ChildByFieldName("body")
+			spaces := cd.MainError.Document.LineAt(body.StartPosition().Line)[:body.StartPosition().Column]
+			s.AddStep("Add `%s %s` at the start of `%s` so that it uses the `%s` defined outside of it.", ctx.keyword, variable, function, variable).
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("%s%s %s\n", spaces, ctx.keyword, variable),
+					StartPosition: lib.Position{Line: body.StartPosition().Line},
+					EndPosition:   lib.Position{Line: body.StartPosition().Line},
+				})
+		})
+
+		// values of enclosing functions cannot be passed to the nested function
+		// since it is not called by name
+		if ctx.keyword != "global" {
+			return
+		}
+
+		calls := []lib.SyntaxNode{}
+		for q := cd.MainError.Document.RootNode().Query(`(call function: (identifier) @name (#eq? @name "%s")) @call`, function); q.Next(); {
+			if q.CurrentTagName() == "call" {
+				calls = append(calls, q.CurrentNode())
+			}
+		}
+
+		if len(calls) == 0 {
+			return
+		}
+
+		gen.Add("Pass the value as a parameter", func(s *lib.BugFixSuggestion) {
+			params := ctx.function.ChildByFieldName("parameters")
+			newParam := variable
+			if params.NamedChildCount() != 0 {
+				newParam = ", " + variable
+			}
+
+			paramsEnd := params.EndPosition().Add(lib.Position{Column: -1, Index: -1})
+			s.AddStep("Add `%s` as a parameter of `%s`.", variable, function).
+				AddFix(lib.FixSuggestion{
+					NewText:       newParam,
+					StartPosition: paramsEnd,
+					EndPosition:   paramsEnd,
+					Description:   fmt.Sprintf("Return `%s` from `%s` if its new value is needed outside of the function.", variable, function),
+				})
+
+			for _, call := range calls {
+				args := call.ChildByFieldName("arguments")
+				newArg := variable
+				if args.NamedChildCount() != 0 {
+					newArg = ", " + variable
+				}
+
+				argsEnd := args.EndPosition().Add(lib.Position{Column: -1, Index: -1})
+				s.AddStep("Give `%s` to `%s` on line %d.", variable, function, call.StartPosition().Line+1).
+					AddFix(lib.FixSuggestion{
+						NewText:       newArg,
+						StartPosition: argsEnd,
+						EndPosition:   argsEnd,
+					})
+			}
+		})
+	},
+}
+
+// findLocalAssignment returns the first assignment to the variable in the
+// function or module. Assignments in nested functions are not included.
+func findLocalAssignment(scope lib.SyntaxNode, variable string) lib.SyntaxNode {
+	for q := scope.Query(`([(assignment left: (identifier) @name) (augmented_assignment left: (identifier) @name) (for_statement left: (identifier) @name)] @assignment (#eq? @name "%s"))`, variable); q.Next(); {
+		if node := q.CurrentNode(); q.CurrentTagName() == "assignment" && enclosingScope(node).StartByte() == scope.StartByte() {
+			return node
+		}
+	}
+	return lib.SyntaxNode{}
+}
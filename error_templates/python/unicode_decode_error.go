@@ -0,0 +1,127 @@
+package python
+
+import (
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type unicodeDecodeErrorCtx struct {
+	// the `open` call of the file which is read
+	call     lib.SyntaxNode
+	encoding lib.SyntaxNode
+}
+
+var UnicodeDecodeError = lib.ErrorTemplate{
+	Name:    "UnicodeDecodeError",
+	Pattern: `UnicodeDecodeError: '(?P<codec>[^']+)' codec can't decode byte (?P<byte>0x[0-9a-fA-F]+) in position (?P<position>\d+): (?P<reason>.+)`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		uCtx := unicodeDecodeErrorCtx{}
+		if calls := callsOnErrorLine(m, "open"); len(calls) != 0 {
+			uCtx.call = calls[0]
+		} else {
+			// the error is raised where the file is read (eg. `f.read()`) so
+			// the `open` call is looked up from the variable of the file
+			uCtx.call = findFileOpener(m)
+		}
+
+		if !uCtx.call.IsNull() {
+			uCtx.encoding = keywordArgument(uCtx.call, "encoding")
+			m.Nearest = uCtx.call
+		}
+		m.Context = uCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(unicodeDecodeErrorCtx)
+		gen.Add(
+			"This error occurs when a text file is read with an encoding which does not match the one used to save it. The file has the byte `%s` at position %s which is not valid in the `%s` encoding.",
+			cd.Variables["byte"], cd.Variables["position"], cd.Variables["codec"],
+		)
+
+		if !ctx.call.IsNull() && ctx.encoding.IsNull() {
+			gen.Add(" No encoding is given to `open()`, so it uses the default encoding of the computer which is not the same on every computer.")
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(unicodeDecodeErrorCtx)
+		if ctx.call.IsNull() {
+			gen.Add("Specify the encoding of the file", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Add `encoding=\"utf-8\"` to the `open()` call of the file.")
+			})
+			return
+		}
+
+		// files which cannot be read as UTF-8 are usually saved with the
+		// encoding of Windows which latin-1 can read without errors
+		encoding := "utf-8"
+		if codec := strings.ReplaceAll(strings.ToLower(cd.Variables["codec"]), "-", ""); codec == "utf8" {
+			encoding = "latin-1"
+		}
+
+		arguments := ctx.call.ChildByFieldName("arguments")
+		if ctx.encoding.IsNull() {
+			gen.Add("Specify the encoding of the file", func(s *lib.BugFixSuggestion) {
+				lastArgument := arguments.LastNamedChild()
+				s.AddStep("Add `encoding=\"%s\"` to `open()` so that the file is read the same way on every computer.", encoding).
+					AddFix(lib.FixSuggestion{
+						NewText:       ", encoding=\"" + encoding + "\"",
+						StartPosition: lastArgument.EndPosition(),
+						EndPosition:   lastArgument.EndPosition(),
+					})
+			})
+		} else if unquote(ctx.encoding.Text()) != encoding {
+			gen.Add("Use a different encoding", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Change the encoding to the one used to save the file, such as `%s`.", encoding).
+					AddFix(lib.FixSuggestion{
+						NewText:       replaceStringValue(ctx.encoding, encoding),
+						StartPosition: ctx.encoding.StartPosition(),
+						EndPosition:   ctx.encoding.EndPosition(),
+					})
+			})
+		}
+
+		if keywordArgument(ctx.call, "errors").IsNull() {
+			gen.Add("Replace the characters which cannot be read", func(s *lib.BugFixSuggestion) {
+				lastArgument := arguments.LastNamedChild()
+				s.AddStep("Add `errors=\"replace\"` to `open()` so that the characters which cannot be read are replaced with `�` instead.").
+					AddFix(lib.FixSuggestion{
+						NewText:       ", errors=\"replace\"",
+						StartPosition: lastArgument.EndPosition(),
+						EndPosition:   lastArgument.EndPosition(),
+						Description:   "Some characters in the file will be lost, so only use this if they are not needed.",
+					})
+			})
+		}
+	},
+}
+
+// findFileOpener returns the `open` call which gives the file to the variable
+// used on the line of the error (eg. `f` in `with open("data.txt") as f`)
+func findFileOpener(m *lib.MainError) lib.SyntaxNode {
+	variables := map[string]bool{}
+	for _, node := range nodesOnErrorLine(m, "name", `(identifier) @name`) {
+		variables[node.Text()] = true
+	}
+
+	var opener lib.SyntaxNode
+	errorLine := m.ErrorNode.StartPos.Line - 1
+	for q := m.Document.RootNode().Query(`[
+		(as_pattern (call function: (identifier) @function (#eq? @function "open")) @call alias: (as_pattern_target (identifier) @name))
+		(assignment left: (identifier) @name right: (call function: (identifier) @function (#eq? @function "open")) @call)
+	]`); q.Next(); {
+		if q.CurrentTagName() != "name" {
+			continue
+		}
+
+		// the last opened file before the error is used when a variable is reused
+		node := q.CurrentNode()
+		if variables[node.Text()] && node.StartPosition().Line <= errorLine {
+			if call := node.Parent().ChildByFieldName("right"); node.Parent().Type() == "assignment" {
+				opener = call
+			} else {
+				opener = node.Parent().Parent().NamedChild(0)
+			}
+		}
+	}
+	return opener
+}
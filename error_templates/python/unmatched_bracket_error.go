@@ -0,0 +1,65 @@
+package python
+
+import (
+	lib "github.com/nedpals/errgoengine"
+)
+
+type unmatchedBracketErrorCtx struct {
+	bracket lib.SyntaxNode
+	// the character which opens the bracket (eg. `(` for `)`)
+	opening string
+}
+
+var UnmatchedBracketError = lib.ErrorTemplate{
+	Name:    "UnmatchedBracketError",
+	Pattern: compileTimeError(`SyntaxError: unmatched '(?P<character>[)\]}])'`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		uCtx := unmatchedBracketErrorCtx{}
+		character := cd.Variables["character"]
+		for opening, closing := range charPairs {
+			if closing == character {
+				uCtx.opening = opening
+			}
+		}
+
+		for _, node := range nodesOnErrorLine(m, "error", `(ERROR) @error`) {
+			for i := 0; i < int(node.ChildCount()); i++ {
+				if child := node.Child(i); child.Type() == character {
+					uCtx.bracket = child
+					m.Nearest = child
+					break
+				}
+			}
+
+			if !uCtx.bracket.IsNull() {
+				break
+			}
+		}
+
+		m.Context = uCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(unmatchedBracketErrorCtx)
+		character := cd.Variables["character"]
+		gen.Add(
+			"This error occurs when there is a %s `%s` without an %s `%s` before it.",
+			charToWord[character], character, charToWord[ctx.opening], ctx.opening,
+		)
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(unmatchedBracketErrorCtx)
+		if ctx.bracket.IsNull() {
+			return
+		}
+
+		character := cd.Variables["character"]
+		gen.Add("Remove the extra "+charToWord[character], func(s *lib.BugFixSuggestion) {
+			s.AddStep("Remove the `%s` which does not close anything, or add the missing `%s` where it should start.", character, ctx.opening).
+				AddFix(lib.FixSuggestion{
+					NewText:       "",
+					StartPosition: ctx.bracket.StartPosition(),
+					EndPosition:   ctx.bracket.EndPosition(),
+				})
+		})
+	},
+}
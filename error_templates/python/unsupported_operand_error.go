@@ -0,0 +1,124 @@
+package python
+
+import (
+	"fmt"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type unsupportedOperandErrorCtx struct {
+	// the binary operator or augmented assignment (eg. `total += age`)
+	expr  lib.SyntaxNode
+	left  lib.SyntaxNode
+	right lib.SyntaxNode
+}
+
+var UnsupportedOperandError = lib.ErrorTemplate{
+	Name:    "UnsupportedOperandError",
+	Pattern: `TypeError: unsupported operand type\(s\) for (?P<operator>\S+)(?: or pow\(\))?: '(?P<leftType>\S+)' and '(?P<rightType>\S+)'`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		uCtx := unsupportedOperandErrorCtx{}
+		operator := cd.Variables["operator"]
+		query := `(binary_operator operator: "%s") @expr`
+		if strings.HasSuffix(operator, "=") {
+			query = `(augmented_assignment operator: "%s") @expr`
+		}
+
+		for _, expr := range nodesOnErrorLine(m, "expr", query, operator) {
+			left, right := expr.ChildByFieldName("left"), expr.ChildByFieldName("right")
+			if matchesType(cd, m.Document, left, cd.Variables["leftType"]) && matchesType(cd, m.Document, right, cd.Variables["rightType"]) {
+				uCtx.expr, uCtx.left, uCtx.right = expr, left, right
+				m.Nearest = expr
+				break
+			}
+		}
+
+		m.Context = uCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(unsupportedOperandErrorCtx)
+		leftType, rightType := cd.Variables["leftType"], cd.Variables["rightType"]
+		gen.Add(
+			"This error occurs when an operator is used with values of types that it cannot combine. `%s` does not work between %s and %s.",
+			cd.Variables["operator"], withArticle(leftType), withArticle(rightType),
+		)
+		if ctx.expr.IsNull() {
+			return
+		}
+
+		for i, operand := range []lib.SyntaxNode{ctx.left, ctx.right} {
+			origin := findValueOrigin(cd, cd.MainError.Document, operand)
+			if isInputCall(origin.value) && !origin.assignment.IsNull() {
+				gen.Add(" `%s` is a `str` because it comes from `input()` on line %d, which always returns text.", operand.Text(), origin.value.StartPosition().Line+1)
+			} else if []string{leftType, rightType}[i] == "NoneType" {
+				explainNoneOrigin(gen, operand, origin)
+			}
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(unsupportedOperandErrorCtx)
+		if ctx.expr.IsNull() {
+			return
+		}
+
+		doc := cd.MainError.Document
+		leftType, rightType := cd.Variables["leftType"], cd.Variables["rightType"]
+		for i, operand := range []lib.SyntaxNode{ctx.left, ctx.right} {
+			if []string{leftType, rightType}[i] == "NoneType" {
+				addNoneFixes(cd, doc, gen, operand)
+				return
+			}
+		}
+
+		text, number := ctx.left, ctx.right
+		numberType := rightType
+		if rightType == "str" {
+			text, number, numberType = ctx.right, ctx.left, leftType
+		}
+
+		if (leftType != "str" && rightType != "str") || (numberType != "int" && numberType != "float") {
+			return
+		}
+
+		gen.Add(fmt.Sprintf("Convert %s to a number", text.Text()), func(s *lib.BugFixSuggestion) {
+			// convert the input once it is read so that the variable can be used as a number everywhere
+			if origin := findValueOrigin(cd, doc, text); isInputCall(origin.value) && !origin.assignment.IsNull() {
+				s.AddStep("Convert the text returned by `input()` into %s as soon as it is read.", withArticle(numberType)).
+					AddFix(lib.FixSuggestion{
+						NewText:       fmt.Sprintf("%s(%s)", numberType, origin.value.Text()),
+						StartPosition: origin.value.StartPosition(),
+						EndPosition:   origin.value.EndPosition(),
+					})
+				return
+			}
+
+			s.AddStep("Use `%s()` to convert `%s` into a number.", numberType, text.Text()).
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("%s(%s)", numberType, text.Text()),
+					StartPosition: text.StartPosition(),
+					EndPosition:   text.EndPosition(),
+				})
+		})
+
+		if strings.TrimSuffix(cd.Variables["operator"], "=") != "+" {
+			return
+		}
+
+		gen.Add(fmt.Sprintf("Convert %s to a string", number.Text()), func(s *lib.BugFixSuggestion) {
+			s.AddStep("Use `str()` to convert `%s` into text if the values should be joined together instead of added.", number.Text()).
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("str(%s)", number.Text()),
+					StartPosition: number.StartPosition(),
+					EndPosition:   number.EndPosition(),
+				})
+		})
+	},
+}
+
+// matchesType checks if the expression can be of the given type. Expressions
+// whose type cannot be guessed match any type.
+func matchesType(cd *lib.ContextData, doc *lib.Document, node lib.SyntaxNode, typeName string) bool {
+	guessed := guessType(cd, doc, node)
+	return len(guessed) == 0 || guessed == typeName
+}
@@ -0,0 +1,57 @@
+package python
+
+import (
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type unterminatedStringErrorCtx struct {
+	// the quote which starts the string
+	quote lib.SyntaxNode
+}
+
+var UnterminatedStringError = lib.ErrorTemplate{
+	Name:    "UnterminatedStringError",
+	Pattern: compileTimeError(`SyntaxError: unterminated string literal \(detected at line \d+\)`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		uCtx := unterminatedStringErrorCtx{}
+		for _, node := range nodesOnErrorLine(m, "error", `(ERROR) @error`) {
+			if first := node.Child(0); strings.HasSuffix(first.Type(), `"`) || strings.HasSuffix(first.Type(), `'`) {
+				uCtx.quote = first
+				m.Nearest = first
+				break
+			}
+		}
+		m.Context = uCtx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(unterminatedStringErrorCtx)
+		gen.Add("This error occurs when a string is not closed with the same quote that it starts with before the end of the line.")
+		if !ctx.quote.IsNull() {
+			gen.Add(" The string which starts on line %d needs a closing `%s`.", ctx.quote.StartPosition().Line+1, closingQuote(ctx.quote))
+		}
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(unterminatedStringErrorCtx)
+		if ctx.quote.IsNull() {
+			return
+		}
+
+		gen.Add("Close the string", func(s *lib.BugFixSuggestion) {
+			line := ctx.quote.StartPosition().Line
+			end := lib.Position{Line: line, Column: len(strings.TrimRight(cd.MainError.Document.LineAt(line), " \t"))}
+			s.AddStep("Add `%s` at the end of the string.", closingQuote(ctx.quote)).
+				AddFix(lib.FixSuggestion{
+					NewText:       closingQuote(ctx.quote),
+					StartPosition: end,
+					EndPosition:   end,
+				})
+		})
+	},
+}
+
+// closingQuote returns the quote which closes the string without its prefix (eg. `"` for `f"`)
+func closingQuote(quote lib.SyntaxNode) string {
+	return quote.Text()[len(quote.Text())-1:]
+}
@@ -0,0 +1,142 @@
+package ruby
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type argumentErrorCtx struct {
+	expected      int
+	given         int
+	argumentsNode lib.SyntaxNode
+	paramNodes    []lib.SyntaxNode
+}
+
+var ArgumentError = lib.ErrorTemplate{
+	Name: "ArgumentError",
+	// the first frame points to the method definition while the next one
+	// (the "from" line) points to where the method was called
+	Pattern: `wrong number of arguments \(given (?P<given>\d+), expected (?P<expected>\d+)[^)]*\) \(ArgumentError\)(?:\n\s+from (?P<callerPath>\S+\.rb):(?P<callerLine>\d+):in .+)?`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := argumentErrorCtx{}
+		ctx.given, _ = strconv.Atoi(cd.Variables["given"])
+		ctx.expected, _ = strconv.Atoi(cd.Variables["expected"])
+
+		methodName := ""
+		if methodNode := getErrorLineNode(m); methodNode.Type() == "method" {
+			methodName = methodNode.ChildByFieldName("name").Text()
+			if paramsNode := methodNode.ChildByFieldName("parameters"); !paramsNode.IsNull() {
+				for i := 0; i < int(paramsNode.NamedChildCount()); i++ {
+					ctx.paramNodes = append(ctx.paramNodes, paramsNode.NamedChild(i))
+				}
+			}
+		}
+
+		callerLine, _ := strconv.Atoi(cd.Variables["callerLine"])
+		if len(methodName) != 0 && callerLine > 0 {
+			for q := m.Document.RootNode().Query(`(call method: (identifier) @method (#eq? @method "%s") arguments: (argument_list) @arguments)`, methodName); q.Next(); {
+				if q.CurrentTagName() != "arguments" {
+					continue
+				}
+
+				node := q.CurrentNode()
+				if node.StartPosition().Line == callerLine-1 {
+					ctx.argumentsNode = node
+					m.Nearest = node
+					break
+				}
+			}
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(argumentErrorCtx)
+		comparison := "more"
+		if ctx.given < ctx.expected {
+			comparison = "fewer"
+		}
+
+		gen.Add("This error occurs when a method is called with %s arguments than it expects. The method expects %d argument(s) but %d were given.", comparison, ctx.expected, ctx.given)
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(argumentErrorCtx)
+		if ctx.argumentsNode.IsNull() {
+			return
+		}
+
+		if ctx.given < ctx.expected {
+			missingParams := []string{}
+			for i := ctx.given; i < ctx.expected && i < len(ctx.paramNodes); i++ {
+				missingParams = append(missingParams, ctx.paramNodes[i].Text())
+			}
+
+			gen.Add("Add the missing arguments", func(s *lib.BugFixSuggestion) {
+				insertPos := ctx.argumentsNode.EndPosition()
+				if lastNode := ctx.argumentsNode.Child(int(ctx.argumentsNode.ChildCount()) - 1); lastNode.Type() == ")" {
+					insertPos = lastNode.StartPosition()
+				}
+
+				newText := strings.Repeat(", nil", ctx.expected-ctx.given)
+				if ctx.given == 0 {
+					newText = strings.TrimPrefix(newText, ", ")
+				}
+
+				s.AddStep("Pass the missing arguments (%s) when calling the method.", wrapNames(missingParams)).
+					AddFix(lib.FixSuggestion{
+						NewText:       newText,
+						StartPosition: insertPos,
+						EndPosition:   insertPos,
+						Description:   "Replace `nil` with the values that you need.",
+					})
+			})
+
+			if len(missingParams) != 0 {
+				gen.Add("Give the parameters a default value", func(s *lib.BugFixSuggestion) {
+					step := s.AddStep("If the arguments are optional, give the parameters (%s) a default value in the method definition.", wrapNames(missingParams))
+					for i := ctx.given; i < ctx.given+len(missingParams); i++ {
+						step.AddFix(lib.FixSuggestion{
+							NewText:       " = nil",
+							StartPosition: ctx.paramNodes[i].EndPosition(),
+							EndPosition:   ctx.paramNodes[i].EndPosition(),
+						})
+					}
+				})
+			}
+		} else if ctx.given > ctx.expected {
+			args := []lib.SyntaxNode{}
+			for i := 0; i < int(ctx.argumentsNode.NamedChildCount()); i++ {
+				args = append(args, ctx.argumentsNode.NamedChild(i))
+			}
+
+			if len(args) > ctx.expected {
+				startPos := ctx.argumentsNode.StartPosition()
+				if ctx.expected == 0 && ctx.argumentsNode.Child(0).Type() == "(" {
+					startPos = ctx.argumentsNode.Child(0).EndPosition()
+				} else if ctx.expected > 0 {
+					startPos = args[ctx.expected-1].EndPosition()
+				}
+
+				gen.Add("Remove the extra arguments", func(s *lib.BugFixSuggestion) {
+					s.AddStep("Remove the extra arguments since the method only accepts %d argument(s).", ctx.expected).
+						AddFix(lib.FixSuggestion{
+							NewText:       "",
+							StartPosition: startPos,
+							EndPosition:   args[len(args)-1].EndPosition(),
+						})
+				})
+			}
+		}
+	},
+}
+
+func wrapNames(names []string) string {
+	wrapped := make([]string, len(names))
+	for i, name := range names {
+		wrapped[i] = fmt.Sprintf("`%s`", name)
+	}
+	return strings.Join(wrapped, ", ")
+}
@@ -0,0 +1,83 @@
+package ruby
+
+import (
+	"fmt"
+	"unicode"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/utils/levenshtein"
+)
+
+type nameErrorCtx struct {
+	similarName string
+}
+
+var NameError = lib.ErrorTemplate{
+	Name:    "NameError",
+	Pattern: `(?:undefined local variable or method [\x60'](?P<name>[^\x60']+)' for .+|uninitialized constant (?P<name>[\w:]+)) \(NameError\)`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := nameErrorCtx{}
+		name := cd.Variables["name"]
+
+		for q := getErrorLineNode(m).Query(`([(identifier) (constant)] @name (#eq? @name "%s"))`, name); q.Next(); {
+			m.Nearest = q.CurrentNode()
+			break
+		}
+
+		// look for a similar name in the current scope (eg. typos)
+		nearestTree := cd.InitOrGetSymbolTree(cd.MainDocumentPath()).GetNearestScopedTree(m.Nearest.StartPosition().Index)
+		nearestDistance := -1
+		for _, sym := range nearestTree.FindSymbolsByClause(func(sym lib.Symbol) bool {
+			return sym.Kind() == lib.SymbolKindAssignment || sym.Kind() == lib.SymbolKindFunction || sym.Kind() == lib.SymbolKindClass
+		}) {
+			distance := levenshtein.ComputeDistance(name, sym.Name())
+			if distance > 2 || (nearestDistance != -1 && distance >= nearestDistance) {
+				continue
+			}
+
+			ctx.similarName = sym.Name()
+			nearestDistance = distance
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		gen.Add("This error occurs when you use a name (`%s`) that Ruby cannot find. It may be misspelled or not defined before it is used.", cd.Variables["name"])
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(nameErrorCtx)
+		name := cd.Variables["name"]
+
+		if len(ctx.similarName) != 0 {
+			gen.Add("Use the correct name", func(s *lib.BugFixSuggestion) {
+				s.AddStep("There is a definition named `%s` which is similar to `%s`. Check if you misspelled the name.", ctx.similarName, name).
+					AddFix(lib.FixSuggestion{
+						NewText:       ctx.similarName,
+						StartPosition: cd.MainError.Nearest.StartPosition(),
+						EndPosition:   cd.MainError.Nearest.EndPosition(),
+					})
+			})
+		}
+
+		if len(name) != 0 && unicode.IsUpper(rune(name[0])) {
+			gen.Add("Define or require the constant", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Make sure that the class or module `%s` is defined, or that the file which defines it is loaded with `require` before it is used.", name)
+			})
+			return
+		}
+
+		gen.Add("Define the variable before using it", func(s *lib.BugFixSuggestion) {
+			statement := getStatementNode(cd.MainError.Nearest)
+			startPos := statement.StartPosition()
+			spaces := getSpaceFromBeginning(cd.MainError.Document, startPos.Line)
+
+			s.AddStep("Make sure to assign a value to `%s` before using it.", name).
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("%s = 0\n%s", name, spaces),
+					StartPosition: startPos,
+					EndPosition:   startPos,
+					Description:   "Replace `0` with the value that you need.",
+				})
+		})
+	},
+}
@@ -0,0 +1,64 @@
+package ruby
+
+import (
+	lib "github.com/nedpals/errgoengine"
+)
+
+type noMethodErrorCtx struct {
+	receiverNode lib.SyntaxNode
+	methodNode   lib.SyntaxNode
+}
+
+var NoMethodError = lib.ErrorTemplate{
+	Name:    "NoMethodError",
+	Pattern: `undefined method [\x60'](?P<method>[^\x60']+)' for nil(?::NilClass)? \(NoMethodError\)`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := noMethodErrorCtx{}
+
+		for q := getErrorLineNode(m).Query(`(call receiver: (_) @receiver method: (identifier) @method (#eq? @method "%s"))`, cd.Variables["method"]); q.Next(); {
+			if q.CurrentTagName() == "receiver" {
+				ctx.receiverNode = q.CurrentNode()
+				continue
+			}
+
+			ctx.methodNode = q.CurrentNode()
+			m.Nearest = ctx.receiverNode
+			break
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(noMethodErrorCtx)
+		if !ctx.receiverNode.IsNull() {
+			gen.Add("This error occurs because you are calling the method `%s` on `%s` which is `nil`. ", cd.Variables["method"], ctx.receiverNode.Text())
+		}
+		gen.Add("`nil` does not have a method named `%s`, which usually means that a value was not set or a method returned `nil` unexpectedly.", cd.Variables["method"])
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(noMethodErrorCtx)
+		if ctx.receiverNode.IsNull() || ctx.methodNode.IsNull() {
+			return
+		}
+
+		gen.Add("Use the safe navigation operator", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Use the safe navigation operator (`&.`) so that the method is only called when `%s` is not `nil`.", ctx.receiverNode.Text()).
+				AddFix(lib.FixSuggestion{
+					NewText:       "&.",
+					StartPosition: ctx.receiverNode.EndPosition(),
+					EndPosition:   ctx.methodNode.StartPosition(),
+				})
+		})
+
+		gen.Add("Check for nil before using it", func(s *lib.BugFixSuggestion) {
+			statement := getStatementNode(ctx.receiverNode)
+
+			s.AddStep("Skip the statement when `%s` is `nil`.", ctx.receiverNode.Text()).
+				AddFix(lib.FixSuggestion{
+					NewText:       " unless " + ctx.receiverNode.Text() + ".nil?",
+					StartPosition: statement.EndPosition(),
+					EndPosition:   statement.EndPosition(),
+				})
+		})
+	},
+}
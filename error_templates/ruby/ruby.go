@@ -0,0 +1,53 @@
+package ruby
+
+import (
+	"strings"
+	"unicode"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/languages/ruby"
+)
+
+func LoadErrorTemplates(errorTemplates *lib.ErrorTemplates) {
+	errorTemplates.MustAdd(ruby.Language, NoMethodError)
+	errorTemplates.MustAdd(ruby.Language, NameError)
+	errorTemplates.MustAdd(ruby.Language, ArgumentError)
+}
+
+func getSpaceFromBeginning(doc *lib.Document, line int) string {
+	lineStr := doc.LineAt(line)
+	return lineStr[:len(lineStr)-len(strings.TrimLeftFunc(lineStr, unicode.IsSpace))]
+}
+
+// getStatementNode returns the nearest statement that contains the node
+func getStatementNode(node lib.SyntaxNode) lib.SyntaxNode {
+	current := node
+	for !current.IsNull() {
+		parent := current.Parent()
+		if parent.IsNull() {
+			break
+		}
+
+		switch parent.Type() {
+		case "program", "body_statement", "method", "then", "else", "do_block", "block", "begin":
+			return current
+		}
+
+		current = parent
+	}
+	return node
+}
+
+// getErrorLineNode returns the statement located at the line reported by the stack trace
+func getErrorLineNode(m *lib.MainError) lib.SyntaxNode {
+	line := m.ErrorNode.StartPos.Line - 1
+	pos := lib.Position{Line: line, Column: len(getSpaceFromBeginning(m.Document, line))}
+	node := m.Document.RootNode().NamedDescendantForPointRange(lib.Location{
+		StartPos: pos,
+		EndPos:   pos,
+	})
+	if node.IsNull() {
+		return m.Nearest
+	}
+	return getStatementNode(node)
+}
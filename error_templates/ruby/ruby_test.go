@@ -0,0 +1,15 @@
+package ruby_test
+
+import (
+	"testing"
+
+	"github.com/nedpals/errgoengine/error_templates/ruby"
+	testutils "github.com/nedpals/errgoengine/error_templates/test_utils"
+)
+
+func TestRubyErrorTemplates(t *testing.T) {
+	testutils.SetupTest(t, testutils.SetupTestConfig{
+		DirName:        "ruby",
+		TemplateLoader: ruby.LoadErrorTemplates,
+	}).Execute(t)
+}
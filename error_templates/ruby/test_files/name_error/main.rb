@@ -0,0 +1,3 @@
+count = 5
+total = cuont + 1
+puts total
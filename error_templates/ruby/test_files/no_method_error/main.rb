@@ -0,0 +1,6 @@
+def find_user(id)
+  nil
+end
+
+user = find_user(1)
+puts user.upcase
@@ -0,0 +1,119 @@
+package rust
+
+import (
+	lib "github.com/nedpals/errgoengine"
+)
+
+type borrowOfMovedValueErrorCtx struct {
+	moveNode      lib.SyntaxNode
+	paramTypeNode lib.SyntaxNode
+}
+
+var BorrowOfMovedValueError = lib.ErrorTemplate{
+	Name:    "BorrowOfMovedValueError",
+	Pattern: comptimeErrorPattern("E0382", `(?:borrow|use) of moved value: \x60(?P<name>[^\x60]+)\x60`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := borrowOfMovedValueErrorCtx{}
+		usageNode := nodeAtErrorPos(m)
+		if !usageNode.IsNull() {
+			m.Nearest = usageNode
+		}
+
+		// the value is moved at the last place where it was used
+		// by value before the reported usage
+		funcNode := getParentNode(usageNode, "function_item")
+		if funcNode.IsNull() {
+			m.Context = ctx
+			return
+		}
+
+		for q := funcNode.Query(`((identifier) @name (#eq? @name "%s"))`, cd.Variables["name"]); q.Next(); {
+			node := q.CurrentNode()
+			if node.StartByte() >= usageNode.StartByte() {
+				break
+			}
+
+			switch parent := node.Parent(); parent.Type() {
+			case "let_declaration", "parameter", "reference_expression", "field_expression", "token_tree":
+				continue
+			}
+
+			ctx.moveNode = node
+		}
+
+		// moving the value into a function from the same file
+		if !ctx.moveNode.IsNull() && ctx.moveNode.Parent().Type() == "arguments" {
+			argsNode := ctx.moveNode.Parent()
+			argIdx := -1
+			for i := 0; i < int(argsNode.NamedChildCount()); i++ {
+				if argsNode.NamedChild(i).StartByte() == ctx.moveNode.StartByte() {
+					argIdx = i
+					break
+				}
+			}
+
+			calleeName := argsNode.Parent().ChildByFieldName("function").Text()
+			for q := m.Document.RootNode().Query(`(function_item name: (identifier) @name (#eq? @name "%s") parameters: (parameters) @parameters)`, calleeName); q.Next(); {
+				if q.CurrentTagName() != "parameters" {
+					continue
+				}
+
+				paramsNode := q.CurrentNode()
+				if argIdx != -1 && argIdx < int(paramsNode.NamedChildCount()) {
+					ctx.paramTypeNode = paramsNode.NamedChild(argIdx).ChildByFieldName("type")
+				}
+				break
+			}
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(borrowOfMovedValueErrorCtx)
+		gen.Add("This error occurs because the value of `%s` was moved ", cd.Variables["name"])
+		if !ctx.moveNode.IsNull() {
+			gen.Add("(at line %d) ", ctx.moveNode.StartPosition().Line+1)
+		}
+		gen.Add("and is used again afterwards. In Rust, a value has only one owner and it cannot be used anymore once its ownership is moved somewhere else.")
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(borrowOfMovedValueErrorCtx)
+		if ctx.moveNode.IsNull() {
+			return
+		}
+
+		name := cd.Variables["name"]
+
+		if !ctx.paramTypeNode.IsNull() {
+			gen.Add("Borrow the value instead of moving it", func(s *lib.BugFixSuggestion) {
+				newType := "&" + ctx.paramTypeNode.Text()
+				if ctx.paramTypeNode.Text() == "String" {
+					newType = "&str"
+				}
+
+				s.AddStep("Pass a reference of `%s` so that the function only borrows the value.", name).
+					AddFix(lib.FixSuggestion{
+						NewText:       "&" + name,
+						StartPosition: ctx.moveNode.StartPosition(),
+						EndPosition:   ctx.moveNode.EndPosition(),
+					})
+
+				s.AddStep("Change the type of the parameter into a reference (`%s`).", newType).
+					AddFix(lib.FixSuggestion{
+						NewText:       newType,
+						StartPosition: ctx.paramTypeNode.StartPosition(),
+						EndPosition:   ctx.paramTypeNode.EndPosition(),
+					})
+			})
+		}
+
+		gen.Add("Clone the value", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Pass a copy of `%s` by cloning it so that the original value can still be used. Note that cloning may be expensive for large values.", name).
+				AddFix(lib.FixSuggestion{
+					NewText:       name + ".clone()",
+					StartPosition: ctx.moveNode.StartPosition(),
+					EndPosition:   ctx.moveNode.EndPosition(),
+				})
+		})
+	},
+}
@@ -0,0 +1,73 @@
+package rust
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/utils/levenshtein"
+)
+
+type cannotFindValueErrorCtx struct {
+	similarName string
+}
+
+var CannotFindValueError = lib.ErrorTemplate{
+	Name:    "CannotFindValueError",
+	Pattern: comptimeErrorPattern("E0425", `cannot find value \x60(?P<name>[^\x60]+)\x60 in this scope`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := cannotFindValueErrorCtx{}
+		name := cd.Variables["name"]
+
+		if node := nodeAtErrorPos(m); node.Type() == "identifier" && node.Text() == name {
+			m.Nearest = node
+		}
+
+		// look for a similar name in the current scope (eg. typos)
+		nearestTree := cd.InitOrGetSymbolTree(cd.MainDocumentPath()).GetNearestScopedTree(m.Nearest.StartPosition().Index)
+		nearestDistance := -1
+		for _, sym := range nearestTree.FindSymbolsByClause(func(sym lib.Symbol) bool {
+			return sym.Kind() == lib.SymbolKindVariable || sym.Kind() == lib.SymbolKindFunction
+		}) {
+			distance := levenshtein.ComputeDistance(name, sym.Name())
+			if distance > 2 || (nearestDistance != -1 && distance >= nearestDistance) {
+				continue
+			}
+
+			ctx.similarName = sym.Name()
+			nearestDistance = distance
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		gen.Add("This error occurs when you use a name (`%s`) that Rust cannot find in the current scope. It may be misspelled or not declared yet.", cd.Variables["name"])
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(cannotFindValueErrorCtx)
+
+		if len(ctx.similarName) != 0 {
+			gen.Add("Use the correct name", func(s *lib.BugFixSuggestion) {
+				s.AddStep("There is a declaration named `%s` which is similar to `%s`. Check if you misspelled the name.", ctx.similarName, cd.Variables["name"]).
+					AddFix(lib.FixSuggestion{
+						NewText:       ctx.similarName,
+						StartPosition: cd.MainError.Nearest.StartPosition(),
+						EndPosition:   cd.MainError.Nearest.EndPosition(),
+					})
+			})
+		}
+
+		gen.Add("Declare the variable before using it", func(s *lib.BugFixSuggestion) {
+			statement := getStatementNode(cd.MainError.Nearest)
+			startPos := statement.StartPosition()
+			spaces := getSpaceFromBeginning(cd.MainError.Document, startPos.Line)
+
+			s.AddStep("Make sure to declare the variable `%s` with `let` before using it.", cd.Variables["name"]).
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("let %s = 0;\n%s", cd.Variables["name"], spaces),
+					StartPosition: startPos,
+					EndPosition:   startPos,
+					Description:   "Replace `0` with the value that you need.",
+				})
+		})
+	},
+}
@@ -0,0 +1,72 @@
+package rust
+
+import (
+	"fmt"
+	"strconv"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type indexOutOfBoundsPanicCtx struct {
+	collectionNode lib.SyntaxNode
+	indexNode      lib.SyntaxNode
+}
+
+var IndexOutOfBoundsPanic = lib.ErrorTemplate{
+	Name:    "IndexOutOfBoundsPanic",
+	Pattern: panicPattern(`index out of bounds: the len is (?P<length>\d+) but the index is (?P<index>\d+)`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := indexOutOfBoundsPanicCtx{}
+
+		if indexExprNode := getParentNode(nodeAtErrorPos(m), "index_expression"); !indexExprNode.IsNull() {
+			ctx.collectionNode = indexExprNode.NamedChild(0)
+			ctx.indexNode = indexExprNode.NamedChild(1)
+			m.Nearest = indexExprNode
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		if cd.Variables["length"] == "0" {
+			gen.Add("This error occurs because the program tried to access the element at index %s of a collection that is empty, so it does not have any valid index.", cd.Variables["index"])
+			return
+		}
+
+		gen.Add("This error occurs because the program tried to access the element at index %s of a collection that only has %s element(s). ", cd.Variables["index"], cd.Variables["length"])
+		gen.Add("Indices start at 0, so the last valid index is %s.", lastIndex(cd.Variables["length"]))
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(indexOutOfBoundsPanicCtx)
+		if ctx.indexNode.IsNull() {
+			return
+		}
+
+		if ctx.indexNode.Type() == "integer_literal" && cd.Variables["length"] != "0" {
+			gen.Add("Use an index within the bounds", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Use an index that is less than the length of `%s` (%s).", ctx.collectionNode.Text(), cd.Variables["length"]).
+					AddFix(lib.FixSuggestion{
+						NewText:       lastIndex(cd.Variables["length"]),
+						StartPosition: ctx.indexNode.StartPosition(),
+						EndPosition:   ctx.indexNode.EndPosition(),
+					})
+			})
+		}
+
+		gen.Add("Access the element safely with `get`", func(s *lib.BugFixSuggestion) {
+			s.AddStep("Use the `get` method which returns `None` instead of panicking when the index is out of bounds, then provide a default value for that case.").
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("%s.get(%s).cloned().unwrap_or_default()", ctx.collectionNode.Text(), ctx.indexNode.Text()),
+					StartPosition: cd.MainError.Nearest.StartPosition(),
+					EndPosition:   cd.MainError.Nearest.EndPosition(),
+				})
+		})
+	},
+}
+
+func lastIndex(length string) string {
+	n, err := strconv.Atoi(length)
+	if err != nil || n == 0 {
+		return "0"
+	}
+	return strconv.Itoa(n - 1)
+}
@@ -0,0 +1,86 @@
+package rust
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type mismatchedTypesErrorCtx struct {
+	valueNode lib.SyntaxNode
+	typeNode  lib.SyntaxNode
+}
+
+var MismatchedTypesError = lib.ErrorTemplate{
+	Name: "MismatchedTypesError",
+	// the expected and found types are in the label below the location
+	Pattern: comptimeErrorPattern("E0308", `mismatched types`) +
+		`(?:\n.*)*?\n.*expected (?:type )?\x60(?P<expectedType>[^\x60]+)\x60, found (?:type )?\x60(?P<actualType>[^\x60]+)\x60.*`,
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := mismatchedTypesErrorCtx{}
+
+		if node := nodeAtErrorPos(m); !node.IsNull() {
+			ctx.valueNode = node
+			m.Nearest = node
+
+			if letNode := getParentNode(node, "let_declaration"); !letNode.IsNull() {
+				ctx.typeNode = letNode.ChildByFieldName("type")
+			}
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		gen.Add(
+			"This error occurs when a value of type `%s` is used where a `%s` is expected. Rust does not convert values between types automatically.",
+			cd.Variables["actualType"],
+			cd.Variables["expectedType"],
+		)
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(mismatchedTypesErrorCtx)
+		if ctx.valueNode.IsNull() {
+			return
+		}
+
+		expectedType := cd.Variables["expectedType"]
+		actualType := cd.Variables["actualType"]
+
+		if converted, desc := convertValue(ctx.valueNode.Text(), actualType, expectedType); len(converted) != 0 {
+			gen.Add("Convert the value", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Convert the value from `%s` to `%s`.", actualType, expectedType).
+					AddFix(lib.FixSuggestion{
+						NewText:       converted,
+						StartPosition: ctx.valueNode.StartPosition(),
+						EndPosition:   ctx.valueNode.EndPosition(),
+						Description:   desc,
+					})
+			})
+		}
+
+		if !ctx.typeNode.IsNull() {
+			gen.Add("Change the variable type", func(s *lib.BugFixSuggestion) {
+				s.AddStep("If the variable is meant to hold a `%s`, change its type to `%s`.", actualType, actualType).
+					AddFix(lib.FixSuggestion{
+						NewText:       actualType,
+						StartPosition: ctx.typeNode.StartPosition(),
+						EndPosition:   ctx.typeNode.EndPosition(),
+					})
+			})
+		}
+	},
+}
+
+func convertValue(value string, from string, to string) (string, string) {
+	switch {
+	case isNumericType(to) && (from == "&str" || from == "String"):
+		return fmt.Sprintf("%s.parse::<%s>().unwrap()", value, to), "Note that `unwrap` stops the program if the text is not a valid number."
+	case isNumericType(to) && isNumericType(from):
+		return fmt.Sprintf("%s as %s", value, to), ""
+	case to == "String" && (from == "&str" || isNumericType(from)):
+		return fmt.Sprintf("%s.to_string()", value), ""
+	case to == "&str" && from == "String":
+		return fmt.Sprintf("&%s", value), ""
+	}
+	return "", ""
+}
@@ -0,0 +1,101 @@
+package rust
+
+import (
+	"fmt"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type multipleMutableBorrowsErrorCtx struct {
+	firstBorrowName   string
+	secondBorrowStmt  lib.SyntaxNode
+	lastFirstUseStmt  lib.SyntaxNode
+	secondBorrowIsUse bool
+}
+
+var MultipleMutableBorrowsError = lib.ErrorTemplate{
+	Name:    "MultipleMutableBorrowsError",
+	Pattern: comptimeErrorPattern("E0499", `cannot borrow \x60(?P<name>[^\x60]+)\x60 as mutable more than once at a time`),
+	OnAnalyzeErrorFn: func(cd *lib.ContextData, m *lib.MainError) {
+		ctx := multipleMutableBorrowsErrorCtx{}
+		secondBorrowNode := getParentNode(nodeAtErrorPos(m), "reference_expression")
+		if secondBorrowNode.IsNull() {
+			m.Context = ctx
+			return
+		}
+
+		m.Nearest = secondBorrowNode
+		ctx.secondBorrowStmt = getStatementNode(secondBorrowNode)
+		blockNode := ctx.secondBorrowStmt.Parent()
+
+		// find the variable holding the first mutable borrow
+		for q := blockNode.Query(`(let_declaration pattern: (identifier) @name value: (reference_expression (mutable_specifier) value: (_) @value (#eq? @value "%s")))`, cd.Variables["name"]); q.Next(); {
+			if q.CurrentTagName() != "name" {
+				continue
+			} else if q.CurrentNode().StartByte() >= ctx.secondBorrowStmt.StartByte() {
+				break
+			}
+			ctx.firstBorrowName = q.CurrentNode().Text()
+		}
+
+		if len(ctx.firstBorrowName) == 0 {
+			m.Context = ctx
+			return
+		}
+
+		// find the last statement that uses the first borrow
+		for q := blockNode.Query(`((identifier) @name (#eq? @name "%s"))`, ctx.firstBorrowName); q.Next(); {
+			node := q.CurrentNode()
+			if node.StartByte() <= ctx.secondBorrowStmt.EndByte() {
+				continue
+			}
+			ctx.lastFirstUseStmt = getStatementNode(node)
+		}
+
+		// moving the second borrow is not possible if both borrows are used in the same statement
+		if !ctx.lastFirstUseStmt.IsNull() && ctx.secondBorrowStmt.Type() == "let_declaration" {
+			secondBorrowName := ctx.secondBorrowStmt.ChildByFieldName("pattern").Text()
+			for q := ctx.lastFirstUseStmt.Query(`((identifier) @name (#eq? @name "%s"))`, secondBorrowName); q.Next(); {
+				ctx.secondBorrowIsUse = true
+				break
+			}
+		}
+
+		m.Context = ctx
+	},
+	OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {
+		ctx := cd.MainError.Context.(multipleMutableBorrowsErrorCtx)
+		gen.Add("This error occurs because `%s` is borrowed as mutable ", cd.Variables["name"])
+		if len(ctx.firstBorrowName) != 0 {
+			gen.Add("(by `%s`) ", ctx.firstBorrowName)
+		}
+		gen.Add("while another mutable borrow of it is still in use. Rust only allows one mutable reference to a value at a time to prevent data races.")
+	},
+	OnGenBugFixFn: func(cd *lib.ContextData, gen *lib.BugFixGenerator) {
+		ctx := cd.MainError.Context.(multipleMutableBorrowsErrorCtx)
+		if ctx.secondBorrowStmt.IsNull() || ctx.lastFirstUseStmt.IsNull() {
+			return
+		}
+
+		if ctx.secondBorrowIsUse {
+			gen.Add("Finish using the first borrow", func(s *lib.BugFixSuggestion) {
+				s.AddStep("Reorganize the code so that `%s` is no longer used once `%s` is borrowed as mutable again.", ctx.firstBorrowName, cd.Variables["name"])
+			})
+			return
+		}
+
+		gen.Add("Borrow again after the first borrow is no longer used", func(s *lib.BugFixSuggestion) {
+			doc := cd.MainError.Document
+			spaces := getSpaceFromBeginning(doc, ctx.secondBorrowStmt.StartPosition().Line)
+			statementsAfter := strings.TrimSpace(doc.Contents[ctx.secondBorrowStmt.EndByte():ctx.lastFirstUseStmt.EndByte()])
+
+			s.AddStep("Move the second mutable borrow after the last use of `%s` so that the two borrows do not overlap.", ctx.firstBorrowName).
+				AddFix(lib.FixSuggestion{
+					NewText:       fmt.Sprintf("%s\n%s%s", statementsAfter, spaces, ctx.secondBorrowStmt.Text()),
+					StartPosition: ctx.secondBorrowStmt.StartPosition(),
+					EndPosition:   ctx.lastFirstUseStmt.EndPosition(),
+				})
+		})
+	},
+}
@@ -0,0 +1,82 @@
+package rust
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/languages/rust"
+)
+
+func LoadErrorTemplates(errorTemplates *lib.ErrorTemplates) {
+	// Compile time
+	errorTemplates.MustAdd(rust.Language, BorrowOfMovedValueError)
+	errorTemplates.MustAdd(rust.Language, MultipleMutableBorrowsError)
+	errorTemplates.MustAdd(rust.Language, MismatchedTypesError)
+	errorTemplates.MustAdd(rust.Language, CannotFindValueError)
+
+	// Runtime
+	errorTemplates.MustAdd(rust.Language, IndexOutOfBoundsPanic)
+}
+
+// compile errors from rustc are keyed by their error code and are in the form of
+// error[E0425]: <message>
+//
+//	--> src/main.rs:3:17
+func comptimeErrorPattern(code string, pattern string) string {
+	return fmt.Sprintf(`error\[%s\]: %s$stacktrace`, code, pattern)
+}
+
+// panics are in the form of
+// thread 'main' panicked at src/main.rs:3:21:
+// <message>
+func panicPattern(pattern string) string {
+	return lib.CustomErrorPattern(`thread '(?P<thread>[^']+)' $stacktrace:\n` + pattern)
+}
+
+// nodeAtErrorPos returns the node located at the position reported by the compiler
+func nodeAtErrorPos(m *lib.MainError) lib.SyntaxNode {
+	pos := lib.Position{Line: m.ErrorNode.StartPos.Line - 1, Column: m.ErrorNode.StartPos.Column}
+	return m.Document.RootNode().NamedDescendantForPointRange(lib.Location{
+		StartPos: pos,
+		EndPos:   pos,
+	})
+}
+
+func getSpaceFromBeginning(doc *lib.Document, line int) string {
+	lineStr := doc.LineAt(line)
+	return lineStr[:len(lineStr)-len(strings.TrimLeftFunc(lineStr, unicode.IsSpace))]
+}
+
+// getStatementNode returns the nearest statement that contains the node
+func getStatementNode(node lib.SyntaxNode) lib.SyntaxNode {
+	current := node
+	for !current.IsNull() {
+		if parent := current.Parent(); parent.IsNull() || parent.Type() == "block" || parent.Type() == "source_file" {
+			break
+		}
+		current = current.Parent()
+	}
+	if current.IsNull() {
+		return node
+	}
+	return current
+}
+
+// getParentNode returns the nearest parent of the node with the given type
+func getParentNode(node lib.SyntaxNode, nodeType string) lib.SyntaxNode {
+	current := node
+	for !current.IsNull() && current.Type() != nodeType {
+		current = current.Parent()
+	}
+	return current
+}
+
+func isNumericType(typ string) bool {
+	switch typ {
+	case "i8", "i16", "i32", "i64", "i128", "isize", "u8", "u16", "u32", "u64", "u128", "usize", "f32", "f64":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,15 @@
+package rust_test
+
+import (
+	"testing"
+
+	"github.com/nedpals/errgoengine/error_templates/rust"
+	testutils "github.com/nedpals/errgoengine/error_templates/test_utils"
+)
+
+func TestRustErrorTemplates(t *testing.T) {
+	testutils.SetupTest(t, testutils.SetupTestConfig{
+		DirName:        "rust",
+		TemplateLoader: rust.LoadErrorTemplates,
+	}).Execute(t)
+}
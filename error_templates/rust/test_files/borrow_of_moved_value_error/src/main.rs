@@ -0,0 +1,9 @@
+fn print_name(name: String) {
+    println!("Hello, {}", name);
+}
+
+fn main() {
+    let name = String::from("John");
+    print_name(name);
+    println!("{}", name.len());
+}
@@ -0,0 +1,5 @@
+fn main() {
+    let count = 5;
+    let total = cuont + 1;
+    println!("{}", total);
+}
@@ -0,0 +1,5 @@
+fn main() {
+    let scores: Vec<i32> = Vec::new();
+    let first = scores[0];
+    println!("{}", first);
+}
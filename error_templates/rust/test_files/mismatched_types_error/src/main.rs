@@ -0,0 +1,5 @@
+fn main() {
+    let input = "5";
+    let count: i32 = input;
+    println!("{}", count + 1);
+}
@@ -59,7 +59,7 @@ func SetupTest(tb testing.TB, cfg SetupTestConfig) TestCases {
 	engine := lib.New()
 	engine.IsTesting = true
 
-	cfg.TemplateLoader(&engine.ErrorTemplates)
+	engine.LoadErrorTemplates(cfg.TemplateLoader)
 
 	// load tests
 	testFilesDirPath := filepath.Join(wd, "test_files")
@@ -111,7 +111,9 @@ func SetupTest(tb testing.TB, cfg SetupTestConfig) TestCases {
 				return e
 			}
 
-			if (expTemp == lib.FallbackErrorTemplate && !d.IsDir()) || expTemp.Language.MatchPath(path) {
+			// other files (eg. text files opened by the program) are included
+			// as well so that templates can check if they exist
+			if !d.IsDir() && (expTemp == lib.FallbackErrorTemplate || expTemp.Language.MatchPath(path) || path != fullTestPath) {
 				fileContent, err := os.ReadFile(path)
 				if err != nil {
 					return err
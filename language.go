@@ -41,6 +41,7 @@ type Language struct {
 	ErrorPattern      string
 	SymbolsToCapture  string
 	LocationConverter func(ctx LocationConverterContext) Location
+	MessageConverter  func(msg string) string
 	AnalyzerFactory   func(cd *ContextData) LanguageAnalyzer
 	ExternFS          fs.ReadFileFS
 }
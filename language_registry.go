@@ -0,0 +1,120 @@
+package errgoengine
+
+import (
+	"regexp"
+	"sort"
+)
+
+// LanguageRegistry keeps track of the languages known to the engine. It is used
+// for selecting the language of a file and for guessing the language of an error
+// message, which is useful for projects with files from multiple languages
+// (eg. Python scripts calling C extensions or Java programs using JNI).
+type LanguageRegistry struct {
+	languages []*Language
+}
+
+func NewLanguageRegistry(languages ...*Language) *LanguageRegistry {
+	reg := &LanguageRegistry{}
+	for _, lang := range languages {
+		reg.Register(lang)
+	}
+	return reg
+}
+
+// Register adds the language into the registry. Languages with the
+// same name as the existing ones are ignored.
+func (reg *LanguageRegistry) Register(lang *Language) {
+	if reg == nil || lang == nil || len(lang.Name) == 0 || reg.Find(lang.Name) != nil {
+		return
+	}
+
+	// compile the stack trace pattern used for detecting the language
+	lang.Compile()
+	reg.languages = append(reg.languages, lang)
+}
+
+func (reg *LanguageRegistry) Languages() []*Language {
+	if reg == nil {
+		return nil
+	}
+	return reg.languages
+}
+
+func (reg *LanguageRegistry) Find(name string) *Language {
+	if reg == nil {
+		return nil
+	}
+
+	for _, lang := range reg.languages {
+		if lang.Name == name {
+			return lang
+		}
+	}
+	return nil
+}
+
+// MatchPath returns the first registered language whose file patterns match the path
+func (reg *LanguageRegistry) MatchPath(path string) *Language {
+	if reg == nil {
+		return nil
+	}
+
+	for _, lang := range reg.languages {
+		if lang.MatchPath(path) {
+			return lang
+		}
+	}
+	return nil
+}
+
+var filePathMentionRegex = regexp.MustCompile(`[^\s"'()\[\]<>,:]+\.\w+`)
+
+// Detect returns the registered languages whose files are mentioned in the
+// error message or whose stack trace format is found in it, starting from
+// the most likely one. Files which exist in the project (as reported by
+// fileExists) weigh more than the rest since stack traces usually include
+// files from the standard library or the runtime.
+func (reg *LanguageRegistry) Detect(msg string, fileExists func(path string) bool) []*Language {
+	if reg == nil {
+		return nil
+	}
+
+	candidates := []*Language{}
+	scores := map[*Language]int{}
+
+	for _, path := range filePathMentionRegex.FindAllString(msg, -1) {
+		lang := reg.MatchPath(path)
+		if lang == nil {
+			continue
+		}
+
+		if _, ok := scores[lang]; !ok {
+			candidates = append(candidates, lang)
+		}
+
+		if fileExists != nil && fileExists(path) {
+			scores[lang] += 10
+		} else {
+			scores[lang]++
+		}
+	}
+
+	// the frames of the stack trace may not have paths (eg. `at Program.Main()`)
+	for _, lang := range reg.languages {
+		if lang.stackTraceRegex == nil || !lang.stackTraceRegex.MatchString(msg) {
+			continue
+		}
+
+		if _, ok := scores[lang]; !ok {
+			candidates = append(candidates, lang)
+		}
+		scores[lang]++
+	}
+
+	// languages with the same score retain the order of their first mention
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return scores[candidates[i]] > scores[candidates[j]]
+	})
+
+	return candidates
+}
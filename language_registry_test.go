@@ -0,0 +1,93 @@
+package errgoengine_test
+
+import (
+	"testing"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/languages/csharp"
+	"github.com/nedpals/errgoengine/languages/java"
+	"github.com/nedpals/errgoengine/languages/kotlin"
+	"github.com/nedpals/errgoengine/languages/python"
+	testutils "github.com/nedpals/errgoengine/test_utils"
+)
+
+func TestLanguageRegistry(t *testing.T) {
+	registry := lib.NewLanguageRegistry(java.Language, python.Language, kotlin.Language)
+
+	t.Run("Register", func(t *testing.T) {
+		registry := lib.NewLanguageRegistry(java.Language)
+		registry.Register(java.Language)
+		registry.Register(python.Language)
+
+		testutils.EqualsList(t, registry.Languages(), []*lib.Language{java.Language, python.Language})
+	})
+
+	t.Run("Find", func(t *testing.T) {
+		testutils.Equals(t, registry.Find("Python"), python.Language)
+		testutils.Equals(t, registry.Find("Rust"), nil)
+	})
+
+	t.Run("MatchPath", func(t *testing.T) {
+		testutils.Equals(t, registry.MatchPath("src/Main.java"), java.Language)
+		testutils.Equals(t, registry.MatchPath("main.py"), python.Language)
+		testutils.Equals(t, registry.MatchPath("Main.kt"), kotlin.Language)
+		testutils.Equals(t, registry.MatchPath("ext.c"), nil)
+	})
+
+	t.Run("Detect", func(t *testing.T) {
+		msg := `Traceback (most recent call last):
+  File "main.py", line 4, in <module>
+    print(a/0)
+ZeroDivisionError: division by zero`
+
+		testutils.EqualsList(t, registry.Detect(msg, nil), []*lib.Language{python.Language})
+	})
+
+	t.Run("Detect/None", func(t *testing.T) {
+		testutils.EqualsList(t, registry.Detect("segmentation fault (core dumped)", nil), []*lib.Language{})
+	})
+
+	t.Run("Detect/StackTraceFormat", func(t *testing.T) {
+		registry := lib.NewLanguageRegistry(java.Language, python.Language, csharp.Language)
+		msg := `Unhandled exception. System.NullReferenceException: Object reference not set to an instance of an object.
+   at Program.Main()`
+
+		testutils.EqualsList(t, registry.Detect(msg, nil), []*lib.Language{csharp.Language})
+	})
+
+	t.Run("Detect/ExistingFiles", func(t *testing.T) {
+		// runtime files outnumber the files from the project
+		msg := `Exception in thread "main" java.lang.NumberFormatException: For input string: "abc"
+	at java.base/java.lang.NumberFormatException.forInputString(NumberFormatException.java:67)
+	at java.base/java.lang.Integer.parseInt(Integer.java:668)
+	at MainKt.main(Main.kt:2)`
+
+		testutils.EqualsList(t, registry.Detect(msg, nil), []*lib.Language{java.Language, kotlin.Language})
+		testutils.EqualsList(t, registry.Detect(msg, func(path string) bool {
+			return path == "Main.kt"
+		}), []*lib.Language{kotlin.Language, java.Language})
+	})
+
+	t.Run("NilRegistry", func(t *testing.T) {
+		var registry *lib.LanguageRegistry
+		testutils.Equals(t, registry.MatchPath("Main.java"), nil)
+		testutils.Equals(t, registry.Find("Java"), nil)
+
+		registry.Register(java.Language)
+		testutils.Equals(t, len(registry.Languages()), 0)
+	})
+
+	t.Run("LoadErrorTemplates", func(t *testing.T) {
+		engine := lib.New()
+		engine.LoadErrorTemplates(func(errorTemplates *lib.ErrorTemplates) {
+			errorTemplates.MustAdd(python.Language, lib.ErrorTemplate{
+				Name:           "ZeroDivisionError",
+				Pattern:        "ZeroDivisionError: division by zero",
+				OnGenExplainFn: func(cd *lib.ContextData, gen *lib.ExplainGenerator) {},
+				OnGenBugFixFn:  func(cd *lib.ContextData, gen *lib.BugFixGenerator) {},
+			})
+		})
+
+		testutils.EqualsList(t, engine.Languages.Languages(), []*lib.Language{python.Language})
+	})
+}
@@ -0,0 +1,45 @@
+package csharp_test
+
+import (
+	"testing"
+
+	csharp "github.com/nedpals/errgoengine/languages/csharp"
+	ltutils "github.com/nedpals/errgoengine/languages/test_utils"
+)
+
+func TestCSharp(t *testing.T) {
+	cases := ltutils.TestCases{
+		ltutils.TestCase{
+			Name:     "Simple",
+			FileName: "Program.cs",
+			Input: `
+class Program {
+	static void Main(string[] args) {
+		int a = 1;
+		double c = 0.5;
+	}
+
+	static int Add(int a, int b) {
+		return a + b;
+	}
+}
+			`,
+			Expected: `
+(tree [0,0 | 0]-[9,1 | 138]
+	(class Program Program [0,0 | 0]-[9,1 | 138]
+		(tree [0,14 | 14]-[9,1 | 138]
+			(function void Main [1,1 | 17]-[4,2 | 84]
+				(tree [1,1 | 17]-[4,2 | 84]
+					(variable string[] args [1,18 | 34]-[1,31 | 47])
+					(variable int a [2,6 | 57]-[2,11 | 62])
+					(variable double c [3,9 | 73]-[3,16 | 80])))
+			(function int Add [6,1 | 87]-[8,2 | 136]
+				(tree [6,1 | 87]-[8,2 | 136]
+					(variable int a [6,16 | 102]-[6,21 | 107])
+					(variable int b [6,23 | 109]-[6,28 | 114]))))))
+			`,
+		},
+	}
+
+	cases.Execute(t, csharp.Language)
+}
@@ -0,0 +1,197 @@
+package csharp
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/smacker/go-tree-sitter/csharp"
+)
+
+//go:embed symbols.txt
+var symbols string
+
+var Language = &lib.Language{
+	Name:              "CSharp",
+	FilePatterns:      []string{".cs"},
+	SitterLanguage:    csharp.GetLanguage(),
+	StackTracePattern: `\s+at (?P<symbol>\S+)\(.*\)(?: in (?P<path>\S+):line (?P<position>\d+))?`,
+	AnalyzerFactory: func(cd *lib.ContextData) lib.LanguageAnalyzer {
+		return &csAnalyzer{cd}
+	},
+	SymbolsToCapture: symbols,
+	LocationConverter: func(ctx lib.LocationConverterContext) lib.Location {
+		// compiler errors include the column (eg. "12,17")
+		// while runtime stack traces only have the line
+		var trueLine, column int
+		if _, err := fmt.Sscanf(ctx.Pos, "%d,%d", &trueLine, &column); err != nil && trueLine == 0 {
+			panic(err)
+		}
+
+		pos := lib.Position{Line: trueLine, Column: max(column-1, 0)}
+		return lib.Location{
+			DocumentPath: ctx.Path,
+			StartPos:     pos,
+			EndPos:       pos,
+		}
+	},
+}
+
+type csAnalyzer struct {
+	*lib.ContextData
+}
+
+func (an *csAnalyzer) FallbackSymbol() lib.Symbol {
+	return BuiltinTypes.VoidSymbol
+}
+
+func (an *csAnalyzer) FindSymbol(name string) lib.Symbol {
+	sym, _ := findBuiltinType(name)
+	return sym
+}
+
+func (an *csAnalyzer) analyzeTypeNode(ctx context.Context, n lib.SyntaxNode) lib.Symbol {
+	switch n.Type() {
+	case "predefined_type", "identifier", "qualified_name":
+		// check for builtin types first
+		builtinSym, found := findBuiltinType(n.Text())
+		if found {
+			return builtinSym
+		}
+		sym := an.ContextData.FindSymbol(n.Text(), int(n.StartByte()))
+		if sym == nil {
+			return lib.UnresolvedSymbol
+		}
+		return sym
+	case "void_keyword":
+		return BuiltinTypes.VoidSymbol
+	case "implicit_type":
+		// `var` is inferred from the value so we
+		// cannot determine the type from here
+		return an.FallbackSymbol()
+	case "nullable_type":
+		return an.analyzeTypeNode(ctx, n.NamedChild(0))
+	case "array_type":
+		len := 0
+		if rankNode := n.ChildByFieldName("rank"); !rankNode.IsNull() && rankNode.NamedChildCount() != 0 {
+			fmt.Sscanf(rankNode.FirstNamedChild().Text(), "%d", &len)
+		}
+
+		elSym := an.analyzeTypeNode(ctx, n.ChildByFieldName("type"))
+		return arrayIfy(elSym, len)
+	case "generic_name":
+		// TODO: type arguments
+		return an.analyzeTypeNode(ctx, n.NamedChild(0))
+	}
+	return lib.UnresolvedSymbol
+}
+
+func isTypeNode(n lib.SyntaxNode) bool {
+	parent := n.Parent()
+	if parent.IsNull() {
+		return false
+	}
+
+	typeNode := parent.ChildByFieldName("type")
+	return !typeNode.IsNull() && typeNode.StartByte() == n.StartByte() && typeNode.EndByte() == n.EndByte()
+}
+
+func (an *csAnalyzer) AnalyzeNode(ctx context.Context, n lib.SyntaxNode) lib.Symbol {
+	symbolTree := lib.GetSymbolTreeCtx(ctx)
+
+	switch n.Type() {
+	// types first
+	case "predefined_type", "void_keyword", "implicit_type", "nullable_type", "array_type", "generic_name", "qualified_name":
+		return an.analyzeTypeNode(ctx, n)
+	// then expressions
+	case "null_literal":
+		return BuiltinTypes.NullSymbol
+	case "boolean_literal":
+		return BuiltinTypes.BooleanSymbol
+	case "string_literal", "verbatim_string_literal", "raw_string_literal", "interpolated_string_expression":
+		return BuiltinTypes.StringSymbol
+	case "character_literal":
+		return BuiltinTypes.Integral.CharSymbol
+	case "integer_literal":
+		if strings.HasSuffix(strings.ToLower(n.Text()), "l") {
+			return BuiltinTypes.Integral.LongSymbol
+		}
+		return BuiltinTypes.Integral.IntSymbol
+	case "real_literal":
+		switch strings.ToLower(n.Text()[len(n.Text())-1:]) {
+		case "f":
+			return BuiltinTypes.FloatingPoint.FloatSymbol
+		case "m":
+			return BuiltinTypes.FloatingPoint.DecimalSymbol
+		default:
+			return BuiltinTypes.FloatingPoint.DoubleSymbol
+		}
+	case "array_creation_expression":
+		return an.AnalyzeNode(ctx, n.NamedChild(0))
+	case "object_creation_expression":
+		return an.AnalyzeNode(ctx, n.ChildByFieldName("type"))
+	case "parenthesized_expression":
+		return an.AnalyzeNode(ctx, n.NamedChild(0))
+	case "identifier":
+		if isTypeNode(n) {
+			return an.analyzeTypeNode(ctx, n)
+		}
+
+		sym := an.ContextData.FindSymbol(n.Text(), int(n.StartByte()))
+		if sym == nil && symbolTree != nil {
+			sym = symbolTree.Find(n.Text())
+		}
+		if sym == nil {
+			return BuiltinTypes.NullSymbol
+		}
+		return sym
+	case "element_access_expression":
+		sym := lib.UnwrapReturnType(an.AnalyzeNode(ctx, n.ChildByFieldName("expression")))
+		if aSym, ok := sym.(ArraySymbol); ok {
+			return aSym.ItemSymbol
+		} else if sym == BuiltinTypes.StringSymbol {
+			return BuiltinTypes.Integral.CharSymbol
+		}
+		return BuiltinTypes.VoidSymbol
+	case "member_access_expression":
+		objNodeSym := lib.UnwrapReturnType(an.AnalyzeNode(ctx, n.ChildByFieldName("expression")))
+		if objNodeSym == BuiltinTypes.NullSymbol {
+			return objNodeSym
+		}
+
+		nameNode := n.ChildByFieldName("name")
+		if sym := lib.GetFromSymbol(lib.CastChildrenSymbol(objNodeSym), nameNode.Text()); sym != nil {
+			return sym
+		}
+	case "invocation_expression":
+		sym := an.AnalyzeNode(ctx, n.ChildByFieldName("function"))
+		if methodSym, ok := sym.(*lib.TopLevelSymbol); ok && methodSym.Kind() == lib.SymbolKindFunction {
+			return methodSym.ReturnType()
+		}
+	case "block":
+		if parent := n.Parent(); parent.Type() == "method_declaration" {
+			return an.AnalyzeNode(ctx, parent.ChildByFieldName("type"))
+		}
+	case "binary_expression":
+		leftSym := lib.UnwrapReturnType(an.AnalyzeNode(ctx, n.ChildByFieldName("left")))
+		rightSym := lib.UnwrapReturnType(an.AnalyzeNode(ctx, n.ChildByFieldName("right")))
+		if leftSym == rightSym {
+			return leftSym
+		} else if leftSym == BuiltinTypes.StringSymbol || rightSym == BuiltinTypes.StringSymbol {
+			// string concatenation
+			return BuiltinTypes.StringSymbol
+		}
+		return BuiltinTypes.VoidSymbol
+	}
+	return BuiltinTypes.VoidSymbol
+}
+
+func (an *csAnalyzer) AnalyzeImport(params lib.ImportParams) lib.ResolvedImport {
+	// TODO:
+
+	return lib.ResolvedImport{
+		Path: "",
+	}
+}
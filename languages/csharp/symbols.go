@@ -0,0 +1,136 @@
+package csharp
+
+import (
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+)
+
+type csharpBuiltinTypeStore struct {
+	typesSymbols map[string]lib.Symbol
+}
+
+func (store *csharpBuiltinTypeStore) Builtin(name string) lib.Symbol {
+	if store.typesSymbols == nil {
+		store.typesSymbols = make(map[string]lib.Symbol)
+	} else if sym, ok := store.FindByName(name); ok {
+		return sym
+	}
+	store.typesSymbols[name] = lib.Builtin(name)
+	return store.typesSymbols[name]
+}
+
+func (store *csharpBuiltinTypeStore) FindByName(name string) (lib.Symbol, bool) {
+	if store.typesSymbols == nil {
+		return nil, false
+	}
+	sym, ok := store.typesSymbols[name]
+	return sym, ok
+}
+
+var builtinTypesStore = &csharpBuiltinTypeStore{}
+
+type ArraySymbol struct {
+	ItemSymbol lib.Symbol
+	Length     int
+}
+
+func (sym ArraySymbol) Name() string {
+	if sym.IsFixed() {
+		return fmt.Sprintf("%s[%d]", sym.ItemSymbol.Name(), sym.Length)
+	}
+	return fmt.Sprintf("%s[]", sym.ItemSymbol.Name())
+}
+
+func (sym ArraySymbol) Kind() lib.SymbolKind {
+	return lib.SymbolKindType
+}
+
+func (sym ArraySymbol) Location() lib.Location {
+	return sym.ItemSymbol.Location()
+}
+
+func (sym ArraySymbol) IsFixed() bool {
+	return sym.Length != -1
+}
+
+// built-in types in C#. aliases such as `Int32` or `String`
+// are resolved to their keyword counterparts
+var BuiltinTypes = struct {
+	NullSymbol    lib.Symbol
+	BooleanSymbol lib.Symbol
+	StringSymbol  lib.Symbol
+	ObjectSymbol  lib.Symbol
+	Integral      struct {
+		ByteSymbol  lib.Symbol
+		ShortSymbol lib.Symbol
+		IntSymbol   lib.Symbol
+		LongSymbol  lib.Symbol
+		CharSymbol  lib.Symbol
+	}
+	FloatingPoint struct {
+		FloatSymbol   lib.Symbol
+		DoubleSymbol  lib.Symbol
+		DecimalSymbol lib.Symbol
+	}
+	VoidSymbol lib.Symbol
+}{
+	NullSymbol:    builtinTypesStore.Builtin("null"),
+	BooleanSymbol: builtinTypesStore.Builtin("bool"),
+	StringSymbol:  builtinTypesStore.Builtin("string"),
+	ObjectSymbol:  builtinTypesStore.Builtin("object"),
+	Integral: struct {
+		ByteSymbol  lib.Symbol
+		ShortSymbol lib.Symbol
+		IntSymbol   lib.Symbol
+		LongSymbol  lib.Symbol
+		CharSymbol  lib.Symbol
+	}{
+		ByteSymbol:  builtinTypesStore.Builtin("byte"),
+		ShortSymbol: builtinTypesStore.Builtin("short"),
+		IntSymbol:   builtinTypesStore.Builtin("int"),
+		LongSymbol:  builtinTypesStore.Builtin("long"),
+		CharSymbol:  builtinTypesStore.Builtin("char"),
+	},
+	FloatingPoint: struct {
+		FloatSymbol   lib.Symbol
+		DoubleSymbol  lib.Symbol
+		DecimalSymbol lib.Symbol
+	}{
+		FloatSymbol:   builtinTypesStore.Builtin("float"),
+		DoubleSymbol:  builtinTypesStore.Builtin("double"),
+		DecimalSymbol: builtinTypesStore.Builtin("decimal"),
+	},
+	VoidSymbol: builtinTypesStore.Builtin("void"),
+}
+
+var typeAliases = map[string]string{
+	"Boolean": "bool",
+	"String":  "string",
+	"Object":  "object",
+	"Byte":    "byte",
+	"Int16":   "short",
+	"Int32":   "int",
+	"Int64":   "long",
+	"Char":    "char",
+	"Single":  "float",
+	"Double":  "double",
+	"Decimal": "decimal",
+}
+
+func findBuiltinType(name string) (lib.Symbol, bool) {
+	if alias, ok := typeAliases[name]; ok {
+		name = alias
+	}
+	return builtinTypesStore.FindByName(name)
+}
+
+func arrayIfy(typ lib.Symbol, len int) lib.Symbol {
+	if len == 0 {
+		len = -1
+	}
+	return ArraySymbol{
+		ItemSymbol: typ,
+		Length:     len,
+	}
+}
@@ -39,6 +39,30 @@ public class Test {
 					(variable int b [6,23 | 121]-[6,28 | 126]))))))
 			`,
 		},
+		ltutils.TestCase{
+			Name:     "Generics",
+			FileName: "Generics.java",
+			Input: `
+public class Generics {
+	public static void main(String[] args) {
+		Map<String, Integer> counts = new HashMap<>();
+		List<String> names = new ArrayList<>();
+		int total = 0;
+	}
+}
+			`,
+			Expected: `
+(tree [0,0 | 0]-[6,1 | 178]
+	(class Generics Generics [0,0 | 0]-[6,1 | 178]
+		(tree [0,22 | 22]-[6,1 | 178]
+			(function void main [1,1 | 25]-[5,2 | 176]
+				(tree [1,1 | 25]-[5,2 | 176]
+					(variable String[] args [1,25 | 49]-[1,38 | 62])
+					(variable Map<String, Integer> counts [2,23 | 89]-[2,47 | 113])
+					(variable List<String> names [3,15 | 130]-[3,40 | 155])
+					(variable int total [4,6 | 163]-[4,15 | 172]))))))
+			`,
+		},
 	}
 
 	cases.Execute(t, java.Language)
@@ -130,6 +130,17 @@ func (an *javaAnalyzer) AnalyzeNode(ctx context.Context, n lib.SyntaxNode) lib.S
 		elNode := n.ChildByFieldName("element")
 		elSym := an.AnalyzeNode(ctx, elNode)
 		return arrayIfy(elSym, len)
+	case "generic_type":
+		typeArgsNode := n.NamedChild(1)
+		typeArgs := make([]lib.Symbol, 0, typeArgsNode.NamedChildCount())
+		for i := 0; i < int(typeArgsNode.NamedChildCount()); i++ {
+			typeArgs = append(typeArgs, an.analyzeTypeArgument(ctx, typeArgsNode.NamedChild(i)))
+		}
+
+		return GenericSymbol{
+			BaseSymbol:    an.analyzeTypeArgument(ctx, n.NamedChild(0)),
+			TypeArguments: typeArgs,
+		}
 	case "void_type":
 		return BuiltinTypes.VoidSymbol
 	case "type_identifier", "boolean_type", "integral_type", "floating_point_type":
@@ -270,6 +281,17 @@ func (an *javaAnalyzer) AnalyzeNode(ctx context.Context, n lib.SyntaxNode) lib.S
 	return BuiltinTypes.VoidSymbol
 }
 
+// analyzeTypeArgument analyzes the type used in a generic type. Classes which
+// are not declared in the project (eg. `List`) are kept by their name so that
+// the generic type can still be described.
+func (an *javaAnalyzer) analyzeTypeArgument(ctx context.Context, n lib.SyntaxNode) lib.Symbol {
+	sym := an.AnalyzeNode(ctx, n)
+	if sym == lib.UnresolvedSymbol {
+		return lib.Builtin(n.Text())
+	}
+	return sym
+}
+
 func (an *javaAnalyzer) AnalyzeImport(params lib.ImportParams) lib.ResolvedImport {
 	// TODO:
 
@@ -2,6 +2,7 @@ package java
 
 import (
 	"fmt"
+	"strings"
 
 	lib "github.com/nedpals/errgoengine"
 )
@@ -54,6 +55,33 @@ func (sym ArraySymbol) IsFixed() bool {
 	return sym.Length != -1
 }
 
+// GenericSymbol is a class given with type arguments (eg. `List<String>`)
+type GenericSymbol struct {
+	BaseSymbol lib.Symbol
+	// empty if the type arguments are inferred (eg. `new ArrayList<>()`)
+	TypeArguments []lib.Symbol
+}
+
+func (sym GenericSymbol) Name() string {
+	typeArgs := make([]string, len(sym.TypeArguments))
+	for i, arg := range sym.TypeArguments {
+		typeArgs[i] = arg.Name()
+	}
+	return fmt.Sprintf("%s<%s>", sym.BaseSymbol.Name(), strings.Join(typeArgs, ", "))
+}
+
+func (sym GenericSymbol) Kind() lib.SymbolKind {
+	return lib.SymbolKindType
+}
+
+func (sym GenericSymbol) Location() lib.Location {
+	return sym.BaseSymbol.Location()
+}
+
+func (sym GenericSymbol) IsDiamond() bool {
+	return len(sym.TypeArguments) == 0
+}
+
 var BuiltinTypes = struct {
 	NullSymbol    lib.Symbol
 	BooleanSymbol lib.Symbol
@@ -0,0 +1,54 @@
+package kotlin_test
+
+import (
+	"testing"
+
+	kotlin "github.com/nedpals/errgoengine/languages/kotlin"
+	ltutils "github.com/nedpals/errgoengine/languages/test_utils"
+)
+
+func TestKotlin(t *testing.T) {
+	cases := ltutils.TestCases{
+		ltutils.TestCase{
+			Name:     "Simple",
+			FileName: "Main.kt",
+			Input: `
+class Person(val name: String) {
+	val nickname: String? = null
+
+	fun greet(other: String): String {
+		return "Hi " + other
+	}
+}
+
+fun add(a: Int, b: Int): Int {
+	return a + b
+}
+
+fun main() {
+	val a: Int = 1
+	var c: Double = 0.5
+}
+			`,
+			Expected: `
+(tree [0,0 | 0]-[15,1 | 228]
+	(class Person Person [0,0 | 0]-[6,1 | 127]
+		(tree [0,31 | 31]-[6,1 | 127]
+			(variable String? nickname [1,5 | 38]-[1,22 | 55])
+			(function String greet [3,1 | 65]-[5,2 | 125]
+				(tree [3,1 | 65]-[5,2 | 125]
+					(variable String other [3,11 | 75]-[3,24 | 88])))))
+	(function Int add [8,0 | 129]-[10,1 | 175]
+		(tree [8,0 | 129]-[10,1 | 175]
+			(variable Int a [8,8 | 137]-[8,14 | 143])
+			(variable Int b [8,16 | 145]-[8,22 | 151])))
+	(function Unit main [12,0 | 177]-[15,1 | 228]
+		(tree [12,0 | 177]-[15,1 | 228]
+			(variable Int a [13,5 | 195]-[13,11 | 201])
+			(variable Double c [14,5 | 211]-[14,14 | 220]))))
+			`,
+		},
+	}
+
+	cases.Execute(t, kotlin.Language)
+}
@@ -0,0 +1,169 @@
+package kotlin
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/smacker/go-tree-sitter/kotlin"
+)
+
+//go:embed symbols.txt
+var symbols string
+
+var Language = &lib.Language{
+	Name:              "Kotlin",
+	FilePatterns:      []string{".kt", ".kts"},
+	SitterLanguage:    kotlin.GetLanguage(),
+	StackTracePattern: `\s+at (?P<symbol>\S+)\((?P<path>\S+):(?P<position>\d+)\)`,
+	AnalyzerFactory: func(cd *lib.ContextData) lib.LanguageAnalyzer {
+		return &kotlinAnalyzer{cd}
+	},
+	SymbolsToCapture: symbols,
+	LocationConverter: func(ctx lib.LocationConverterContext) lib.Location {
+		// compiler errors include the column (eg. "12:17")
+		// while runtime stack traces only have the line
+		var trueLine, column int
+		if _, err := fmt.Sscanf(ctx.Pos, "%d:%d", &trueLine, &column); err != nil && trueLine == 0 {
+			panic(err)
+		}
+
+		pos := lib.Position{Line: trueLine, Column: max(column-1, 0)}
+		return lib.Location{
+			DocumentPath: ctx.Path,
+			StartPos:     pos,
+			EndPos:       pos,
+		}
+	},
+}
+
+type kotlinAnalyzer struct {
+	*lib.ContextData
+}
+
+func (an *kotlinAnalyzer) FallbackSymbol() lib.Symbol {
+	return BuiltinTypes.UnitSymbol
+}
+
+func (an *kotlinAnalyzer) FindSymbol(name string) lib.Symbol {
+	sym, _ := builtinTypesStore.FindByName(name)
+	return sym
+}
+
+func (an *kotlinAnalyzer) analyzeTypeNode(ctx context.Context, n lib.SyntaxNode) lib.Symbol {
+	switch n.Type() {
+	case "user_type":
+		// TODO: type arguments
+		return an.analyzeTypeNode(ctx, n.NamedChild(0))
+	case "type_identifier":
+		if builtinSym, found := builtinTypesStore.FindByName(n.Text()); found {
+			return builtinSym
+		}
+		sym := an.ContextData.FindSymbol(n.Text(), int(n.StartByte()))
+		if sym == nil {
+			return lib.UnresolvedSymbol
+		}
+		return sym
+	case "nullable_type":
+		return nullabilify(an.analyzeTypeNode(ctx, n.NamedChild(0)))
+	case "parenthesized_type":
+		return an.analyzeTypeNode(ctx, n.NamedChild(0))
+	}
+	return lib.UnresolvedSymbol
+}
+
+func (an *kotlinAnalyzer) AnalyzeNode(ctx context.Context, n lib.SyntaxNode) lib.Symbol {
+	symbolTree := lib.GetSymbolTreeCtx(ctx)
+
+	switch n.Type() {
+	// types first
+	case "user_type", "type_identifier", "nullable_type", "parenthesized_type":
+		return an.analyzeTypeNode(ctx, n)
+	// then expressions
+	case "null":
+		return NullSymbol
+	case "boolean_literal":
+		return BuiltinTypes.BooleanSymbol
+	case "line_string_literal", "multi_line_string_literal":
+		return BuiltinTypes.StringSymbol
+	case "character_literal":
+		return BuiltinTypes.CharSymbol
+	case "integer_literal", "hex_literal", "bin_literal", "unsigned_literal":
+		return BuiltinTypes.IntSymbol
+	case "long_literal":
+		return BuiltinTypes.LongSymbol
+	case "real_literal":
+		if strings.HasSuffix(strings.ToLower(n.Text()), "f") {
+			return BuiltinTypes.FloatSymbol
+		}
+		return BuiltinTypes.DoubleSymbol
+	case "parenthesized_expression":
+		return an.AnalyzeNode(ctx, n.NamedChild(0))
+	case "simple_identifier":
+		sym := an.ContextData.FindSymbol(n.Text(), int(n.StartByte()))
+		if sym == nil && symbolTree != nil {
+			sym = symbolTree.Find(n.Text())
+		}
+		if sym == nil {
+			return lib.UnresolvedSymbol
+		}
+		return sym
+	case "postfix_expression":
+		sym := an.AnalyzeNode(ctx, n.NamedChild(0))
+		if n.Child(int(n.ChildCount())-1).Type() == "!!" {
+			// non-null assertion strips off the nullability of the type
+			if nSym, ok := lib.UnwrapReturnType(sym).(NullableSymbol); ok {
+				return nSym.Symbol
+			}
+		}
+		return sym
+	case "navigation_expression":
+		objNodeSym := lib.UnwrapReturnType(an.AnalyzeNode(ctx, n.NamedChild(0)))
+		isSafeCall := false
+		if nSym, ok := objNodeSym.(NullableSymbol); ok {
+			objNodeSym = nSym.Symbol
+			isSafeCall = n.NamedChild(1).Child(0).Type() == "?."
+		}
+
+		nameNode := n.NamedChild(1).LastNamedChild()
+		sym := lib.GetFromSymbol(lib.CastChildrenSymbol(objNodeSym), nameNode.Text())
+		if sym == nil {
+			return an.FallbackSymbol()
+		} else if isSafeCall {
+			return nullabilify(lib.UnwrapReturnType(sym))
+		}
+		return sym
+	case "call_expression":
+		sym := an.AnalyzeNode(ctx, n.NamedChild(0))
+		if topSym, ok := sym.(*lib.TopLevelSymbol); ok {
+			if topSym.Kind() == lib.SymbolKindFunction {
+				return topSym.ReturnType()
+			} else if topSym.Kind() == lib.SymbolKindClass {
+				// constructors do not need the `new` keyword in kotlin
+				return topSym
+			}
+		}
+	case "multiplicative_expression", "additive_expression":
+		leftSym := lib.UnwrapReturnType(an.AnalyzeNode(ctx, n.NamedChild(0)))
+		rightSym := lib.UnwrapReturnType(an.AnalyzeNode(ctx, n.NamedChild(1)))
+		if leftSym == rightSym {
+			return leftSym
+		} else if n.Type() == "additive_expression" && leftSym == BuiltinTypes.StringSymbol {
+			// string concatenation
+			return BuiltinTypes.StringSymbol
+		}
+	case "comparison_expression", "equality_expression", "conjunction_expression", "disjunction_expression":
+		return BuiltinTypes.BooleanSymbol
+	}
+	return an.FallbackSymbol()
+}
+
+func (an *kotlinAnalyzer) AnalyzeImport(params lib.ImportParams) lib.ResolvedImport {
+	// TODO:
+
+	return lib.ResolvedImport{
+		Path: "",
+	}
+}
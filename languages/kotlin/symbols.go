@@ -0,0 +1,87 @@
+package kotlin
+
+import (
+	lib "github.com/nedpals/errgoengine"
+)
+
+type kotlinBuiltinTypeStore struct {
+	typesSymbols map[string]lib.Symbol
+}
+
+func (store *kotlinBuiltinTypeStore) Builtin(name string) lib.Symbol {
+	if store.typesSymbols == nil {
+		store.typesSymbols = make(map[string]lib.Symbol)
+	} else if sym, ok := store.FindByName(name); ok {
+		return sym
+	}
+	store.typesSymbols[name] = lib.Builtin(name)
+	return store.typesSymbols[name]
+}
+
+func (store *kotlinBuiltinTypeStore) FindByName(name string) (lib.Symbol, bool) {
+	if store.typesSymbols == nil {
+		return nil, false
+	}
+	sym, ok := store.typesSymbols[name]
+	return sym, ok
+}
+
+var builtinTypesStore = &kotlinBuiltinTypeStore{}
+
+// built-in types in kotlin
+var BuiltinTypes = struct {
+	NothingSymbol lib.Symbol
+	AnySymbol     lib.Symbol
+	UnitSymbol    lib.Symbol
+	BooleanSymbol lib.Symbol
+	StringSymbol  lib.Symbol
+	CharSymbol    lib.Symbol
+	IntSymbol     lib.Symbol
+	LongSymbol    lib.Symbol
+	FloatSymbol   lib.Symbol
+	DoubleSymbol  lib.Symbol
+}{
+	NothingSymbol: builtinTypesStore.Builtin("Nothing"),
+	AnySymbol:     builtinTypesStore.Builtin("Any"),
+	UnitSymbol:    builtinTypesStore.Builtin("Unit"),
+	BooleanSymbol: builtinTypesStore.Builtin("Boolean"),
+	StringSymbol:  builtinTypesStore.Builtin("String"),
+	CharSymbol:    builtinTypesStore.Builtin("Char"),
+	IntSymbol:     builtinTypesStore.Builtin("Int"),
+	LongSymbol:    builtinTypesStore.Builtin("Long"),
+	FloatSymbol:   builtinTypesStore.Builtin("Float"),
+	DoubleSymbol:  builtinTypesStore.Builtin("Double"),
+}
+
+// NullableSymbol represents a type that may also hold `null` (eg. `String?`)
+type NullableSymbol struct {
+	Symbol lib.Symbol
+}
+
+func (sym NullableSymbol) Name() string {
+	return sym.Symbol.Name() + "?"
+}
+
+func (sym NullableSymbol) Kind() lib.SymbolKind {
+	return lib.SymbolKindType
+}
+
+func (sym NullableSymbol) Location() lib.Location {
+	return sym.Symbol.Location()
+}
+
+// NullSymbol is the type of the `null` literal which is `Nothing?` in kotlin
+var NullSymbol lib.Symbol = NullableSymbol{BuiltinTypes.NothingSymbol}
+
+func nullabilify(sym lib.Symbol) lib.Symbol {
+	if _, ok := sym.(NullableSymbol); ok {
+		return sym
+	}
+	return NullableSymbol{sym}
+}
+
+// IsNullable checks if the given symbol's type accepts `null`
+func IsNullable(sym lib.Symbol) bool {
+	_, ok := lib.UnwrapReturnType(sym).(NullableSymbol)
+	return ok
+}
@@ -2,11 +2,21 @@ package languages
 
 import (
 	lib "github.com/nedpals/errgoengine"
+	"github.com/nedpals/errgoengine/languages/csharp"
 	"github.com/nedpals/errgoengine/languages/java"
+	"github.com/nedpals/errgoengine/languages/kotlin"
+	"github.com/nedpals/errgoengine/languages/php"
 	"github.com/nedpals/errgoengine/languages/python"
+	"github.com/nedpals/errgoengine/languages/ruby"
+	"github.com/nedpals/errgoengine/languages/rust"
 )
 
 var SupportedLanguages = []*lib.Language{
 	java.Language,
 	python.Language,
+	csharp.Language,
+	kotlin.Language,
+	ruby.Language,
+	php.Language,
+	rust.Language,
 }
@@ -0,0 +1,131 @@
+package php
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/smacker/go-tree-sitter/php"
+)
+
+//go:embed symbols.txt
+var symbols string
+
+var Language = &lib.Language{
+	Name:           "PHP",
+	FilePatterns:   []string{".php"},
+	SitterLanguage: php.GetLanguage(),
+	// warnings end with "in /path/file.php on line 12" while uncaught
+	// errors end with "in /path/file.php:12"
+	StackTracePattern: `\s+in (?P<path>\S+\.php)(?: on line |:)(?P<position>\d+)`,
+	ErrorPattern:      `(?:PHP )?$message$stacktrace`,
+	AnalyzerFactory: func(cd *lib.ContextData) lib.LanguageAnalyzer {
+		return &phpAnalyzer{cd}
+	},
+	SymbolsToCapture: symbols,
+	LocationConverter: func(ctx lib.LocationConverterContext) lib.Location {
+		var trueLine int
+		if _, err := fmt.Sscanf(ctx.Pos, "%d", &trueLine); err != nil {
+			panic(err)
+		}
+		return lib.Location{
+			DocumentPath: ctx.Path,
+			StartPos:     lib.Position{Line: trueLine},
+			EndPos:       lib.Position{Line: trueLine},
+		}
+	},
+}
+
+type phpAnalyzer struct {
+	*lib.ContextData
+}
+
+func (an *phpAnalyzer) FallbackSymbol() lib.Symbol {
+	return BuiltinTypes.MixedSymbol
+}
+
+func (an *phpAnalyzer) FindSymbol(name string) lib.Symbol {
+	sym, _ := builtinTypesStore.FindByName(strings.ToLower(name))
+	return sym
+}
+
+func (an *phpAnalyzer) analyzeTypeNode(ctx context.Context, n lib.SyntaxNode) lib.Symbol {
+	switch n.Type() {
+	case "primitive_type":
+		if sym, found := builtinTypesStore.FindByName(strings.ToLower(n.Text())); found {
+			return sym
+		}
+	case "type_name":
+		sym := an.ContextData.FindSymbol(n.Text(), int(n.StartByte()))
+		if sym == nil {
+			return lib.UnresolvedSymbol
+		}
+		return sym
+	case "optional_type":
+		// TODO: nullable types
+		return an.analyzeTypeNode(ctx, n.NamedChild(0))
+	}
+	return BuiltinTypes.MixedSymbol
+}
+
+func (an *phpAnalyzer) AnalyzeNode(ctx context.Context, n lib.SyntaxNode) lib.Symbol {
+	switch n.Type() {
+	// types first
+	case "primitive_type", "type_name", "optional_type":
+		return an.analyzeTypeNode(ctx, n)
+	// then expressions
+	case "null":
+		return BuiltinTypes.NullSymbol
+	case "boolean":
+		return BuiltinTypes.BoolSymbol
+	case "string", "encapsed_string", "heredoc":
+		return BuiltinTypes.StringSymbol
+	case "integer":
+		return BuiltinTypes.IntSymbol
+	case "float":
+		return BuiltinTypes.FloatSymbol
+	case "array_creation_expression":
+		return BuiltinTypes.ArraySymbol
+	case "parenthesized_expression":
+		return an.AnalyzeNode(ctx, n.NamedChild(0))
+	case "object_creation_expression":
+		return an.AnalyzeNode(ctx, n.NamedChild(0))
+	case "name", "qualified_name":
+		sym := an.ContextData.FindSymbol(n.Text(), int(n.StartByte()))
+		if sym == nil {
+			return lib.UnresolvedSymbol
+		}
+		return sym
+	case "variable_name":
+		sym := an.ContextData.FindSymbol(n.Text(), int(n.StartByte()))
+		if sym == nil {
+			return BuiltinTypes.NullSymbol
+		}
+		return sym
+	case "function_call_expression":
+		sym := an.AnalyzeNode(ctx, n.ChildByFieldName("function"))
+		if topSym, ok := sym.(*lib.TopLevelSymbol); ok && topSym.Kind() == lib.SymbolKindFunction {
+			return topSym.ReturnType()
+		}
+	case "binary_expression":
+		leftSym := lib.UnwrapReturnType(an.AnalyzeNode(ctx, n.ChildByFieldName("left")))
+		rightSym := lib.UnwrapReturnType(an.AnalyzeNode(ctx, n.ChildByFieldName("right")))
+		if n.ChildByFieldName("operator").Type() == "." {
+			// string concatenation
+			return BuiltinTypes.StringSymbol
+		} else if leftSym == rightSym {
+			return leftSym
+		}
+	}
+	return BuiltinTypes.MixedSymbol
+}
+
+func (an *phpAnalyzer) AnalyzeImport(params lib.ImportParams) lib.ResolvedImport {
+	// TODO:
+
+	return lib.ResolvedImport{
+		Path: "",
+	}
+}
@@ -0,0 +1,50 @@
+package php_test
+
+import (
+	"testing"
+
+	php "github.com/nedpals/errgoengine/languages/php"
+	ltutils "github.com/nedpals/errgoengine/languages/test_utils"
+)
+
+func TestPHP(t *testing.T) {
+	cases := ltutils.TestCases{
+		ltutils.TestCase{
+			Name:     "Simple",
+			FileName: "index.php",
+			Input: `
+<?php
+class User {
+	private $name = "";
+
+	public function getName(): string {
+		return $this->name;
+	}
+}
+
+function add(int $a, int $b): int {
+	return $a + $b;
+}
+
+$count = 5;
+$user = null;
+			`,
+			Expected: `
+(tree [0,0 | 0]-[14,5 | 179]
+	(class User User [1,0 | 6]-[7,1 | 104]
+		(tree [1,11 | 17]-[7,1 | 104]
+			(variable mixed $name [2,9 | 28]-[2,19 | 38])
+			(function string getName [4,1 | 42]-[6,2 | 102]
+				(tree [4,1 | 42]-[6,2 | 102]))))
+	(function int add [9,0 | 106]-[11,1 | 160]
+		(tree [9,0 | 106]-[11,1 | 160]
+			(variable int $a [9,13 | 119]-[9,19 | 125])
+			(variable int $b [9,21 | 127]-[9,27 | 133])))
+	(assignment int $count [13,0 | 162]-[13,6 | 168])
+	(assignment null $user [14,0 | 174]-[14,5 | 179]))
+			`,
+		},
+	}
+
+	cases.Execute(t, php.Language)
+}
@@ -0,0 +1,52 @@
+package php
+
+import (
+	lib "github.com/nedpals/errgoengine"
+)
+
+type phpBuiltinTypeStore struct {
+	typesSymbols map[string]lib.Symbol
+}
+
+func (store *phpBuiltinTypeStore) Builtin(name string) lib.Symbol {
+	if store.typesSymbols == nil {
+		store.typesSymbols = make(map[string]lib.Symbol)
+	} else if sym, ok := store.FindByName(name); ok {
+		return sym
+	}
+	store.typesSymbols[name] = lib.Builtin(name)
+	return store.typesSymbols[name]
+}
+
+func (store *phpBuiltinTypeStore) FindByName(name string) (lib.Symbol, bool) {
+	if store.typesSymbols == nil {
+		return nil, false
+	}
+	sym, ok := store.typesSymbols[name]
+	return sym, ok
+}
+
+var builtinTypesStore = &phpBuiltinTypeStore{}
+
+// built-in types in php
+var BuiltinTypes = struct {
+	NullSymbol   lib.Symbol
+	MixedSymbol  lib.Symbol
+	VoidSymbol   lib.Symbol
+	BoolSymbol   lib.Symbol
+	StringSymbol lib.Symbol
+	IntSymbol    lib.Symbol
+	FloatSymbol  lib.Symbol
+	ArraySymbol  lib.Symbol
+	ObjectSymbol lib.Symbol
+}{
+	NullSymbol:   builtinTypesStore.Builtin("null"),
+	MixedSymbol:  builtinTypesStore.Builtin("mixed"),
+	VoidSymbol:   builtinTypesStore.Builtin("void"),
+	BoolSymbol:   builtinTypesStore.Builtin("bool"),
+	StringSymbol: builtinTypesStore.Builtin("string"),
+	IntSymbol:    builtinTypesStore.Builtin("int"),
+	FloatSymbol:  builtinTypesStore.Builtin("float"),
+	ArraySymbol:  builtinTypesStore.Builtin("array"),
+	ObjectSymbol: builtinTypesStore.Builtin("object"),
+}
@@ -3,6 +3,9 @@ package python
 import (
 	"context"
 	_ "embed"
+	"io/fs"
+	"path/filepath"
+	"strings"
 
 	lib "github.com/nedpals/errgoengine"
 	"github.com/smacker/go-tree-sitter/python"
@@ -15,7 +18,7 @@ var Language = &lib.Language{
 	Name:              "Python",
 	FilePatterns:      []string{".py"},
 	SitterLanguage:    python.GetLanguage(),
-	StackTracePattern: `\s+File "(?P<path>\S+)", line (?P<position>\d+)(?:, in (?P<symbol>\S+))?(?:\n.+(?:\n[ ^~]+)?\n)?`,
+	StackTracePattern: `\s+File "(?P<path>\S+)", line (?P<position>\d+)(?:, in (?P<symbol>\S+))?(?:\n.+(?:\n[ ^~]+)?\n)?(?:\s+\[Previous line repeated \d+ more times?\]\n)?`,
 	ErrorPattern:      `$stacktrace$message`,
 	AnalyzerFactory: func(cd *lib.ContextData) lib.LanguageAnalyzer {
 		return &pyAnalyzer{cd}
@@ -124,9 +127,57 @@ func (an *pyAnalyzer) AnalyzeNode(ctx context.Context, n lib.SyntaxNode) lib.Sym
 }
 
 func (an *pyAnalyzer) AnalyzeImport(params lib.ImportParams) lib.ResolvedImport {
-	// TODO:
+	resolved := lib.ResolvedImport{}
+	moduleNode := lib.SyntaxNode{}
 
-	return lib.ResolvedImport{
-		Path: "",
+	switch params.Node.Type() {
+	case "import_statement":
+		moduleNode = params.Node.ChildByFieldName("name")
+		if moduleNode.Type() == "aliased_import" {
+			resolved.Name = moduleNode.ChildByFieldName("alias").Text()
+			moduleNode = moduleNode.ChildByFieldName("name")
+		}
+	case "import_from_statement":
+		moduleNode = params.Node.ChildByFieldName("module_name")
+		for i := 1; i < int(params.Node.NamedChildCount()); i++ {
+			name := params.Node.NamedChild(i)
+			if name.Type() == "aliased_import" {
+				name = name.ChildByFieldName("name")
+			}
+
+			if name.Type() == "dotted_name" {
+				resolved.Symbols = append(resolved.Symbols, name.Text())
+			}
+		}
+	}
+
+	if moduleNode.IsNull() {
+		return resolved
+	}
+
+	if len(resolved.Name) == 0 {
+		resolved.Name = strings.TrimLeft(moduleNode.Text(), ".")
+	}
+
+	// modules are looked up from the directory of the file which imports them
+	dir := filepath.Join(params.CurrentDir, filepath.Dir(an.CurrentDocumentPath))
+	resolved.Path = ResolveModulePath(an.FS, dir, moduleNode.Text())
+	return resolved
+}
+
+// ResolveModulePath returns the path of the file of the module (eg. `shapes/circle.py`
+// for `shapes.circle`) which is located in the given directory or an empty string
+// if the module is not found (eg. modules of the standard library).
+func ResolveModulePath(files fs.FS, dir string, module string) string {
+	if files == nil {
+		return ""
+	}
+
+	modulePath := filepath.Join(dir, strings.ReplaceAll(strings.TrimLeft(module, "."), ".", "/"))
+	for _, path := range []string{modulePath + ".py", filepath.Join(modulePath, "__init__.py")} {
+		if _, err := fs.Stat(files, filepath.ToSlash(path)); err == nil {
+			return filepath.ToSlash(path)
+		}
 	}
+	return ""
 }
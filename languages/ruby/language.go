@@ -0,0 +1,123 @@
+package ruby
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/smacker/go-tree-sitter/ruby"
+)
+
+//go:embed symbols.txt
+var symbols string
+
+var Language = &lib.Language{
+	Name:           "Ruby",
+	FilePatterns:   []string{".rb"},
+	SitterLanguage: ruby.GetLanguage(),
+	// the innermost frame is printed before the message (eg. "main.rb:3:in `greet': ...")
+	// while the rest of the frames are printed after it with a "from" prefix
+	StackTracePattern: `(?:\s+from )?(?P<path>\S+\.rb):(?P<position>\d+):in [\x60'](?P<symbol>[^\x60']+)'`,
+	ErrorPattern:      `$stacktrace: $message`,
+	AnalyzerFactory: func(cd *lib.ContextData) lib.LanguageAnalyzer {
+		return &rubyAnalyzer{cd}
+	},
+	SymbolsToCapture: symbols,
+	LocationConverter: func(ctx lib.LocationConverterContext) lib.Location {
+		var trueLine int
+		if _, err := fmt.Sscanf(ctx.Pos, "%d", &trueLine); err != nil {
+			panic(err)
+		}
+		return lib.Location{
+			DocumentPath: ctx.Path,
+			StartPos:     lib.Position{Line: trueLine},
+			EndPos:       lib.Position{Line: trueLine},
+		}
+	},
+}
+
+type rubyAnalyzer struct {
+	*lib.ContextData
+}
+
+func (an *rubyAnalyzer) FallbackSymbol() lib.Symbol {
+	return BuiltinTypes.ObjectSymbol
+}
+
+func (an *rubyAnalyzer) FindSymbol(name string) lib.Symbol {
+	sym, _ := builtinTypesStore.FindByName(name)
+	return sym
+}
+
+func (an *rubyAnalyzer) AnalyzeNode(ctx context.Context, n lib.SyntaxNode) lib.Symbol {
+	switch n.Type() {
+	case "nil":
+		return BuiltinTypes.NilSymbol
+	case "true":
+		return BuiltinTypes.TrueSymbol
+	case "false":
+		return BuiltinTypes.FalseSymbol
+	case "string", "heredoc_beginning":
+		return BuiltinTypes.StringSymbol
+	case "simple_symbol", "delimited_symbol":
+		return BuiltinTypes.SymbolSymbol
+	case "integer":
+		return BuiltinTypes.IntegerSymbol
+	case "float":
+		return BuiltinTypes.FloatSymbol
+	case "array":
+		return BuiltinTypes.ArraySymbol
+	case "hash":
+		return BuiltinTypes.HashSymbol
+	case "parenthesized_statements":
+		return an.AnalyzeNode(ctx, n.LastNamedChild())
+	case "constant":
+		if sym, found := builtinTypesStore.FindByName(n.Text()); found {
+			return sym
+		}
+		sym := an.ContextData.FindSymbol(n.Text(), int(n.StartByte()))
+		if sym == nil {
+			return lib.UnresolvedSymbol
+		}
+		return sym
+	case "identifier":
+		sym := an.ContextData.FindSymbol(n.Text(), int(n.StartByte()))
+		if sym == nil {
+			return lib.UnresolvedSymbol
+		}
+		return sym
+	case "call":
+		receiverNode := n.ChildByFieldName("receiver")
+		methodNode := n.ChildByFieldName("method")
+		if receiverNode.IsNull() {
+			sym := an.AnalyzeNode(ctx, methodNode)
+			if topSym, ok := sym.(*lib.TopLevelSymbol); ok && topSym.Kind() == lib.SymbolKindFunction {
+				return topSym.ReturnType()
+			}
+			break
+		}
+
+		receiverSym := lib.UnwrapReturnType(an.AnalyzeNode(ctx, receiverNode))
+		if receiverSym == BuiltinTypes.NilSymbol {
+			return receiverSym
+		} else if topSym, ok := receiverSym.(*lib.TopLevelSymbol); ok && topSym.Kind() == lib.SymbolKindClass && methodNode.Text() == "new" {
+			return topSym
+		}
+	case "binary":
+		leftSym := lib.UnwrapReturnType(an.AnalyzeNode(ctx, n.ChildByFieldName("left")))
+		rightSym := lib.UnwrapReturnType(an.AnalyzeNode(ctx, n.ChildByFieldName("right")))
+		if leftSym == rightSym {
+			return leftSym
+		}
+	}
+	return BuiltinTypes.ObjectSymbol
+}
+
+func (an *rubyAnalyzer) AnalyzeImport(params lib.ImportParams) lib.ResolvedImport {
+	// TODO:
+
+	return lib.ResolvedImport{
+		Path: "",
+	}
+}
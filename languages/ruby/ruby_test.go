@@ -0,0 +1,54 @@
+package ruby_test
+
+import (
+	"testing"
+
+	ruby "github.com/nedpals/errgoengine/languages/ruby"
+	ltutils "github.com/nedpals/errgoengine/languages/test_utils"
+)
+
+func TestRuby(t *testing.T) {
+	cases := ltutils.TestCases{
+		ltutils.TestCase{
+			Name:     "Simple",
+			FileName: "main.rb",
+			Input: `
+class Person
+	def initialize(name, age = 0)
+		@name = name
+	end
+
+	def greet
+		"Hi"
+	end
+end
+
+def add(a, b)
+	a + b
+end
+
+count = 5
+user = nil
+			`,
+			Expected: `
+(tree [0,0 | 0]-[15,4 | 133]
+	(class Person Person [0,0 | 0]-[8,3 | 91]
+		(tree [0,0 | 0]-[8,3 | 91]
+			(function Object initialize [1,1 | 14]-[3,4 | 63]
+				(tree [1,1 | 14]-[3,4 | 63]
+					(variable Object name [1,15 | 28]-[1,30 | 43])
+					(variable Object age [1,22 | 35]-[1,29 | 42])))
+			(function Object greet [5,1 | 66]-[7,4 | 87]
+				(tree [5,1 | 66]-[7,4 | 87]))))
+	(function Object add [10,0 | 93]-[12,3 | 117]
+		(tree [10,0 | 93]-[12,3 | 117]
+			(variable Object a [10,7 | 100]-[10,13 | 106])
+			(variable Object b [10,7 | 100]-[10,13 | 106])))
+	(assignment Integer count [14,0 | 119]-[14,5 | 124])
+	(assignment NilClass user [15,0 | 129]-[15,4 | 133]))
+			`,
+		},
+	}
+
+	cases.Execute(t, ruby.Language)
+}
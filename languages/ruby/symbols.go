@@ -0,0 +1,54 @@
+package ruby
+
+import (
+	lib "github.com/nedpals/errgoengine"
+)
+
+type rubyBuiltinTypeStore struct {
+	typesSymbols map[string]lib.Symbol
+}
+
+func (store *rubyBuiltinTypeStore) Builtin(name string) lib.Symbol {
+	if store.typesSymbols == nil {
+		store.typesSymbols = make(map[string]lib.Symbol)
+	} else if sym, ok := store.FindByName(name); ok {
+		return sym
+	}
+	store.typesSymbols[name] = lib.Builtin(name)
+	return store.typesSymbols[name]
+}
+
+func (store *rubyBuiltinTypeStore) FindByName(name string) (lib.Symbol, bool) {
+	if store.typesSymbols == nil {
+		return nil, false
+	}
+	sym, ok := store.typesSymbols[name]
+	return sym, ok
+}
+
+var builtinTypesStore = &rubyBuiltinTypeStore{}
+
+// built-in classes in ruby
+var BuiltinTypes = struct {
+	NilSymbol     lib.Symbol
+	ObjectSymbol  lib.Symbol
+	TrueSymbol    lib.Symbol
+	FalseSymbol   lib.Symbol
+	StringSymbol  lib.Symbol
+	SymbolSymbol  lib.Symbol
+	IntegerSymbol lib.Symbol
+	FloatSymbol   lib.Symbol
+	ArraySymbol   lib.Symbol
+	HashSymbol    lib.Symbol
+}{
+	NilSymbol:     builtinTypesStore.Builtin("NilClass"),
+	ObjectSymbol:  builtinTypesStore.Builtin("Object"),
+	TrueSymbol:    builtinTypesStore.Builtin("TrueClass"),
+	FalseSymbol:   builtinTypesStore.Builtin("FalseClass"),
+	StringSymbol:  builtinTypesStore.Builtin("String"),
+	SymbolSymbol:  builtinTypesStore.Builtin("Symbol"),
+	IntegerSymbol: builtinTypesStore.Builtin("Integer"),
+	FloatSymbol:   builtinTypesStore.Builtin("Float"),
+	ArraySymbol:   builtinTypesStore.Builtin("Array"),
+	HashSymbol:    builtinTypesStore.Builtin("Hash"),
+}
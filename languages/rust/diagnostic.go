@@ -0,0 +1,104 @@
+package rust
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// diagnostic is a compiler message emitted by rustc with --error-format=json
+type diagnostic struct {
+	Message string `json:"message"`
+	Code    *struct {
+		Code string `json:"code"`
+	} `json:"code"`
+	Level    string           `json:"level"`
+	Spans    []diagnosticSpan `json:"spans"`
+	Children []diagnostic     `json:"children"`
+}
+
+type diagnosticSpan struct {
+	FileName    string  `json:"file_name"`
+	LineStart   int     `json:"line_start"`
+	ColumnStart int     `json:"column_start"`
+	IsPrimary   bool    `json:"is_primary"`
+	Label       *string `json:"label"`
+}
+
+// cargo wraps the diagnostics from rustc when using --message-format=json
+type cargoMessage struct {
+	Reason  string          `json:"reason"`
+	Message json.RawMessage `json:"message"`
+}
+
+func (d diagnostic) primarySpan() (diagnosticSpan, bool) {
+	for _, span := range d.Spans {
+		if span.IsPrimary {
+			return span, true
+		}
+	}
+	return diagnosticSpan{}, false
+}
+
+// String renders the diagnostic in the same shape as the human-readable output of rustc
+func (d diagnostic) String() string {
+	sb := &strings.Builder{}
+	sb.WriteString(d.Level)
+	if d.Code != nil && len(d.Code.Code) != 0 {
+		fmt.Fprintf(sb, "[%s]", d.Code.Code)
+	}
+	fmt.Fprintf(sb, ": %s", d.Message)
+
+	if span, ok := d.primarySpan(); ok {
+		fmt.Fprintf(sb, "\n --> %s:%d:%d\n  |", span.FileName, span.LineStart, span.ColumnStart)
+		if span.Label != nil {
+			fmt.Fprintf(sb, "\n  = %s", *span.Label)
+		}
+	}
+
+	for _, child := range d.Children {
+		fmt.Fprintf(sb, "\n  = %s: %s", child.Level, child.Message)
+	}
+
+	return sb.String()
+}
+
+// convertJSONDiagnostics converts the first error from the JSON output of rustc
+// (or cargo) into the human-readable format. JSON diagnostics are preferred when
+// available since the location of the error is reported without ambiguity.
+func convertJSONDiagnostics(msg string) string {
+	if !strings.HasPrefix(strings.TrimSpace(msg), "{") {
+		return msg
+	}
+
+	for _, line := range strings.Split(msg, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "{") {
+			continue
+		}
+
+		data := []byte(line)
+
+		var wrapped cargoMessage
+		if err := json.Unmarshal(data, &wrapped); err != nil {
+			continue
+		} else if len(wrapped.Reason) != 0 {
+			if wrapped.Reason != "compiler-message" {
+				continue
+			}
+			data = wrapped.Message
+		}
+
+		var diag diagnostic
+		if err := json.Unmarshal(data, &diag); err != nil {
+			continue
+		} else if _, hasSpan := diag.primarySpan(); diag.Level != "error" || !hasSpan {
+			// skip warnings and summaries (eg. "aborting due to previous error")
+			continue
+		}
+
+		return diag.String()
+	}
+
+	return msg
+}
@@ -0,0 +1,164 @@
+package rust
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	lib "github.com/nedpals/errgoengine"
+	"github.com/smacker/go-tree-sitter/rust"
+)
+
+//go:embed symbols.txt
+var symbols string
+
+var Language = &lib.Language{
+	Name:           "Rust",
+	FilePatterns:   []string{".rs"},
+	SitterLanguage: rust.GetLanguage(),
+	// compile errors point to the location with an arrow (eg. " --> src/main.rs:5:20")
+	// while panics include it in the first line (eg. "thread 'main' panicked at src/main.rs:4:5:")
+	StackTracePattern: `(?:\s+--> |panicked at )(?P<path>\S+\.rs):(?P<position>\d+:\d+)`,
+	AnalyzerFactory: func(cd *lib.ContextData) lib.LanguageAnalyzer {
+		return &rustAnalyzer{cd}
+	},
+	SymbolsToCapture: symbols,
+	LocationConverter: func(ctx lib.LocationConverterContext) lib.Location {
+		var trueLine, column int
+		if _, err := fmt.Sscanf(ctx.Pos, "%d:%d", &trueLine, &column); err != nil && trueLine == 0 {
+			panic(err)
+		}
+
+		pos := lib.Position{Line: trueLine, Column: max(column-1, 0)}
+		return lib.Location{
+			DocumentPath: ctx.Path,
+			StartPos:     pos,
+			EndPos:       pos,
+		}
+	},
+	MessageConverter: convertJSONDiagnostics,
+}
+
+type rustAnalyzer struct {
+	*lib.ContextData
+}
+
+func (an *rustAnalyzer) FallbackSymbol() lib.Symbol {
+	return BuiltinTypes.UnitSymbol
+}
+
+func (an *rustAnalyzer) FindSymbol(name string) lib.Symbol {
+	sym, _ := builtinTypesStore.FindByName(name)
+	return sym
+}
+
+func (an *rustAnalyzer) analyzeTypeNode(ctx context.Context, n lib.SyntaxNode) lib.Symbol {
+	switch n.Type() {
+	case "primitive_type", "type_identifier":
+		if builtinSym, found := builtinTypesStore.FindByName(n.Text()); found {
+			return builtinSym
+		}
+		sym := an.ContextData.FindSymbol(n.Text(), int(n.StartByte()))
+		if sym == nil {
+			return lib.UnresolvedSymbol
+		}
+		return sym
+	case "generic_type":
+		// TODO: type arguments
+		return an.analyzeTypeNode(ctx, n.ChildByFieldName("type"))
+	case "reference_type":
+		if builtinSym, found := builtinTypesStore.FindByName(strings.ReplaceAll(n.Text(), " ", "")); found {
+			return builtinSym
+		}
+		// TODO: references
+		return an.analyzeTypeNode(ctx, n.ChildByFieldName("type"))
+	case "unit_type":
+		return BuiltinTypes.UnitSymbol
+	}
+	return lib.UnresolvedSymbol
+}
+
+func (an *rustAnalyzer) AnalyzeNode(ctx context.Context, n lib.SyntaxNode) lib.Symbol {
+	switch n.Type() {
+	// types first
+	case "primitive_type", "type_identifier", "generic_type", "reference_type", "unit_type":
+		return an.analyzeTypeNode(ctx, n)
+	// then expressions
+	case "boolean_literal":
+		return BuiltinTypes.BoolSymbol
+	case "char_literal":
+		return BuiltinTypes.CharSymbol
+	case "string_literal", "raw_string_literal":
+		return BuiltinTypes.StrSymbol
+	case "integer_literal", "float_literal":
+		// literals may have a type suffix (eg. 5u8, 1.5f32)
+		text := n.Text()
+		for _, suffix := range []string{"i8", "i16", "i32", "i64", "i128", "isize", "u8", "u16", "u32", "u64", "u128", "usize", "f32", "f64"} {
+			if strings.HasSuffix(text, suffix) {
+				sym, _ := builtinTypesStore.FindByName(suffix)
+				return sym
+			}
+		}
+		if n.Type() == "float_literal" {
+			return BuiltinTypes.F64Symbol
+		}
+		return BuiltinTypes.I32Symbol
+	case "parenthesized_expression":
+		return an.AnalyzeNode(ctx, n.NamedChild(0))
+	case "identifier":
+		sym := an.ContextData.FindSymbol(n.Text(), int(n.StartByte()))
+		if sym == nil {
+			return lib.UnresolvedSymbol
+		}
+		return sym
+	case "macro_invocation":
+		switch n.ChildByFieldName("macro").Text() {
+		case "vec":
+			return BuiltinTypes.VecSymbol
+		case "format":
+			return BuiltinTypes.StringSymbol
+		}
+	case "call_expression":
+		funcNode := n.ChildByFieldName("function")
+		if funcNode.Type() == "scoped_identifier" {
+			// constructors (eg. String::from, Vec::new)
+			if sym := an.AnalyzeNode(ctx, funcNode.ChildByFieldName("path")); sym != lib.UnresolvedSymbol {
+				return lib.UnwrapReturnType(sym)
+			}
+			break
+		}
+
+		sym := an.AnalyzeNode(ctx, funcNode)
+		if topSym, ok := sym.(*lib.TopLevelSymbol); ok && topSym.Kind() == lib.SymbolKindFunction {
+			return topSym.ReturnType()
+		}
+	case "reference_expression":
+		sym := lib.UnwrapReturnType(an.AnalyzeNode(ctx, n.ChildByFieldName("value")))
+		if sym == BuiltinTypes.StringSymbol {
+			// &String is commonly coerced into &str
+			return BuiltinTypes.StrSymbol
+		}
+		return sym
+	case "binary_expression":
+		switch n.ChildByFieldName("operator").Type() {
+		case "==", "!=", "<", ">", "<=", ">=", "&&", "||":
+			return BuiltinTypes.BoolSymbol
+		}
+
+		leftSym := lib.UnwrapReturnType(an.AnalyzeNode(ctx, n.ChildByFieldName("left")))
+		rightSym := lib.UnwrapReturnType(an.AnalyzeNode(ctx, n.ChildByFieldName("right")))
+		if leftSym == rightSym {
+			return leftSym
+		}
+	}
+	return an.FallbackSymbol()
+}
+
+func (an *rustAnalyzer) AnalyzeImport(params lib.ImportParams) lib.ResolvedImport {
+	// TODO:
+
+	return lib.ResolvedImport{
+		Path: "",
+	}
+}
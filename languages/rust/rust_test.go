@@ -0,0 +1,74 @@
+package rust_test
+
+import (
+	"testing"
+
+	rust "github.com/nedpals/errgoengine/languages/rust"
+	ltutils "github.com/nedpals/errgoengine/languages/test_utils"
+	testutils "github.com/nedpals/errgoengine/test_utils"
+)
+
+func TestRust(t *testing.T) {
+	cases := ltutils.TestCases{
+		ltutils.TestCase{
+			Name:     "Simple",
+			FileName: "main.rs",
+			Input: `
+struct Point {
+	x: i32,
+	y: i32,
+}
+
+fn add(a: i32, b: i32) -> i32 {
+	a + b
+}
+
+fn main() {
+	let list = vec![1, 2, 3];
+	let name: String = String::from("hi");
+	let count = add(1, 2);
+}
+			`,
+			Expected: `
+(tree [0,0 | 0]-[13,1 | 182]
+	(class Point Point [0,0 | 0]-[3,1 | 34]
+		(tree [0,13 | 13]-[3,1 | 34]
+			(variable i32 x [1,1 | 16]-[1,7 | 22])
+			(variable i32 y [2,1 | 25]-[2,7 | 31])))
+	(function i32 add [5,0 | 36]-[7,1 | 76]
+		(tree [5,0 | 36]-[7,1 | 76]
+			(variable i32 a [5,7 | 43]-[5,13 | 49])
+			(variable i32 b [5,15 | 51]-[5,21 | 57])))
+	(function () main [9,0 | 78]-[13,1 | 182]
+		(tree [9,0 | 78]-[13,1 | 182]
+			(variable () list [10,1 | 91]-[10,26 | 116])
+			(variable String name [11,1 | 118]-[11,39 | 156])
+			(variable () count [12,1 | 158]-[12,23 | 180]))))
+			`,
+		},
+	}
+
+	cases.Execute(t, rust.Language)
+}
+
+func TestMessageConverter(t *testing.T) {
+	t.Run("Human", func(t *testing.T) {
+		msg := "error[E0425]: cannot find value `x` in this scope\n --> src/main.rs:2:20"
+		testutils.Equals(t, rust.Language.MessageConverter(msg), msg)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		msg := `{"$message_type":"diagnostic","message":"mismatched types","code":{"code":"E0308","explanation":"..."},"level":"error","spans":[{"file_name":"src/main.rs","line_start":2,"column_start":18,"is_primary":true,"label":"expected ` + "`i32`, found `&str`" + `"}],"children":[],"rendered":"..."}
+{"$message_type":"diagnostic","message":"aborting due to 1 previous error","code":null,"level":"error","spans":[],"children":[],"rendered":"..."}`
+
+		testutils.Equals(t, rust.Language.MessageConverter(msg), "error[E0308]: mismatched types\n --> src/main.rs:2:18\n  |\n  = expected `i32`, found `&str`")
+	})
+
+	t.Run("Cargo", func(t *testing.T) {
+		msg := `{"reason":"compiler-artifact","package_id":"app 0.1.0"}
+{"reason":"compiler-message","package_id":"app 0.1.0","message":{"message":"unused variable: ` + "`a`" + `","code":{"code":"unused_variables"},"level":"warning","spans":[{"file_name":"src/main.rs","line_start":1,"column_start":9,"is_primary":true,"label":null}],"children":[]}}
+{"reason":"compiler-message","package_id":"app 0.1.0","message":{"message":"cannot find value ` + "`x`" + ` in this scope","code":{"code":"E0425"},"level":"error","spans":[{"file_name":"src/main.rs","line_start":2,"column_start":20,"is_primary":true,"label":"not found in this scope"}],"children":[{"message":"a local variable with a similar name exists: ` + "`a`" + `","level":"help","spans":[],"children":[]}]}}`
+
+		testutils.Equals(t, rust.Language.MessageConverter(msg), "error[E0425]: cannot find value `x` in this scope\n --> src/main.rs:2:20\n  |\n  = not found in this scope\n  = help: a local variable with a similar name exists: `a`")
+	})
+}
@@ -0,0 +1,74 @@
+package rust
+
+import (
+	lib "github.com/nedpals/errgoengine"
+)
+
+type rustBuiltinTypeStore struct {
+	typesSymbols map[string]lib.Symbol
+}
+
+func (store *rustBuiltinTypeStore) Builtin(name string) lib.Symbol {
+	if store.typesSymbols == nil {
+		store.typesSymbols = make(map[string]lib.Symbol)
+	} else if sym, ok := store.FindByName(name); ok {
+		return sym
+	}
+	store.typesSymbols[name] = lib.Builtin(name)
+	return store.typesSymbols[name]
+}
+
+func (store *rustBuiltinTypeStore) FindByName(name string) (lib.Symbol, bool) {
+	if store.typesSymbols == nil {
+		return nil, false
+	}
+	sym, ok := store.typesSymbols[name]
+	return sym, ok
+}
+
+var builtinTypesStore = &rustBuiltinTypeStore{}
+
+// built-in types in rust
+var BuiltinTypes = struct {
+	UnitSymbol   lib.Symbol
+	BoolSymbol   lib.Symbol
+	CharSymbol   lib.Symbol
+	StrSymbol    lib.Symbol
+	StringSymbol lib.Symbol
+	VecSymbol    lib.Symbol
+	I8Symbol     lib.Symbol
+	I16Symbol    lib.Symbol
+	I32Symbol    lib.Symbol
+	I64Symbol    lib.Symbol
+	I128Symbol   lib.Symbol
+	IsizeSymbol  lib.Symbol
+	U8Symbol     lib.Symbol
+	U16Symbol    lib.Symbol
+	U32Symbol    lib.Symbol
+	U64Symbol    lib.Symbol
+	U128Symbol   lib.Symbol
+	UsizeSymbol  lib.Symbol
+	F32Symbol    lib.Symbol
+	F64Symbol    lib.Symbol
+}{
+	UnitSymbol:   builtinTypesStore.Builtin("()"),
+	BoolSymbol:   builtinTypesStore.Builtin("bool"),
+	CharSymbol:   builtinTypesStore.Builtin("char"),
+	StrSymbol:    builtinTypesStore.Builtin("&str"),
+	StringSymbol: builtinTypesStore.Builtin("String"),
+	VecSymbol:    builtinTypesStore.Builtin("Vec"),
+	I8Symbol:     builtinTypesStore.Builtin("i8"),
+	I16Symbol:    builtinTypesStore.Builtin("i16"),
+	I32Symbol:    builtinTypesStore.Builtin("i32"),
+	I64Symbol:    builtinTypesStore.Builtin("i64"),
+	I128Symbol:   builtinTypesStore.Builtin("i128"),
+	IsizeSymbol:  builtinTypesStore.Builtin("isize"),
+	U8Symbol:     builtinTypesStore.Builtin("u8"),
+	U16Symbol:    builtinTypesStore.Builtin("u16"),
+	U32Symbol:    builtinTypesStore.Builtin("u32"),
+	U64Symbol:    builtinTypesStore.Builtin("u64"),
+	U128Symbol:   builtinTypesStore.Builtin("u128"),
+	UsizeSymbol:  builtinTypesStore.Builtin("usize"),
+	F32Symbol:    builtinTypesStore.Builtin("f32"),
+	F64Symbol:    builtinTypesStore.Builtin("f64"),
+}
@@ -2,6 +2,7 @@ package testutils
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"testing"
 
@@ -53,7 +54,7 @@ func treeSexprBuilder(tree *lib.SymbolTree, sb *strings.Builder, level int) {
 	if len(tree.Symbols) > 0 {
 		sb.WriteByte('\n')
 		i := 0
-		for _, sym := range tree.Symbols {
+		for _, sym := range sortedSymbols(tree) {
 			if i != 0 && i < len(tree.Symbols) {
 				sb.WriteByte('\n')
 			}
@@ -76,3 +77,22 @@ func treeSexprBuilder(tree *lib.SymbolTree, sb *strings.Builder, level int) {
 	}
 	sb.WriteByte(')')
 }
+
+// sortedSymbols returns the symbols of the tree ordered by their position
+// in the document so that the generated output is deterministic
+func sortedSymbols(tree *lib.SymbolTree) []lib.Symbol {
+	symbols := make([]lib.Symbol, 0, len(tree.Symbols))
+	for _, sym := range tree.Symbols {
+		symbols = append(symbols, sym)
+	}
+
+	sort.Slice(symbols, func(i, j int) bool {
+		a, b := symbols[i].Location().StartPos.Index, symbols[j].Location().StartPos.Index
+		if a == b {
+			return symbols[i].Name() < symbols[j].Name()
+		}
+		return a < b
+	})
+
+	return symbols
+}
@@ -54,7 +54,11 @@ func (gen *OutputGenerator) Writeln(str string, d ...any) {
 }
 
 func (gen *OutputGenerator) Write(str string, d ...any) {
-	final := fmt.Sprintf(str, d...)
+	// the text may already be formatted (eg. code with `%`)
+	final := str
+	if len(d) != 0 {
+		final = fmt.Sprintf(str, d...)
+	}
 	for _, c := range final {
 		if c == '\t' {
 			// 1 tab = 4 spaces
@@ -124,10 +128,20 @@ func (gen *OutputGenerator) Generate(explain *ExplainGenerator, bugFix *BugFixGe
 
 					gen.Writeln("```diff")
 
+					// replacements which are shorter than the lines they replace
+					// still have lines to show
+					hasNewText := false
+					for _, fix := range step.Fixes {
+						if len(fix.NewText) != 0 {
+							hasNewText = true
+							break
+						}
+					}
+
 					// use origStartLine instead of startLine because we want to show the original lines
 					if startLine > 0 {
 						deduct := -2
-						if step.DiffPosition.Line < 0 {
+						if step.DiffPosition.Line < 0 && !hasNewText {
 							deduct += step.DiffPosition.Line
 						}
 						gen.WriteLines(step.Doc.LinesAt(origStartLine+deduct, origStartLine-1)...)
@@ -147,7 +161,7 @@ func (gen *OutputGenerator) Generate(explain *ExplainGenerator, bugFix *BugFixGe
 					}
 
 					// show this only if the total is not negative
-					if startLine >= origStartLine && afterLine >= origAfterLine {
+					if startLine >= origStartLine && (afterLine >= origAfterLine || hasNewText) {
 						// TODO: redundant
 						modified := step.Doc.ModifiedLinesAt(startLine, afterLine)
 						// TODO: merge with previous `original` variable
@@ -322,10 +322,10 @@ func (doc *EditableDocument) Apply(changeset Changeset) Position {
 
 			if line == changeset.EndPos.Line {
 				endPos.Column = changeset.EndPos.Column
-			} else if line < len(doc.modifiedLines) {
-				// if the line is the last line, set the end position to the length of the line.
-				// take note, this is the length of the original line, not the modified line
-				endPos.Column = len(doc.modifiedLines[line])
+			} else if line+diffPosition.Line < len(doc.modifiedLines) {
+				// if the line is not the last line, set the end position to the length of the line.
+				// take note, the lines above it may have been removed already
+				endPos.Column = len(doc.modifiedLines[line+diffPosition.Line])
 			}
 
 			finalChangeset := Changeset{
@@ -333,7 +333,7 @@ func (doc *EditableDocument) Apply(changeset Changeset) Position {
 				StartPos:  startPos,
 				EndPos:    endPos,
 				IsChanged: true, // turn it on so that FillIndex will be called in the earlier part of this function
-			}.Add(diffPosition)
+			}.Add(Position{Line: diffPosition.Line}) // columns removed from the lines above do not affect this line
 
 			// avoid removals with 0 difference in range
 			if finalChangeset.StartPos.Eq(finalChangeset.EndPos) {
@@ -348,6 +348,32 @@ func (doc *EditableDocument) Apply(changeset Changeset) Position {
 		return diffPosition
 	}
 
+	// if the changeset replaces text which spans multiple lines, delete the lines first
+	// and then insert the new text in place of them
+	if len(changeset.NewText) != 0 && changeset.EndPos.Line > changeset.StartPos.Line {
+		// the first line is removed as a whole if the range starts from
+		// its beginning so the new text has to be put on its own line
+		newText := changeset.NewText
+		if changeset.StartPos.Column == 0 && !strings.HasSuffix(newText, "\n") {
+			newText += "\n"
+		}
+
+		diffPosition = doc.Apply(Changeset{
+			Id:        changeset.Id,
+			StartPos:  changeset.StartPos,
+			EndPos:    changeset.EndPos,
+			IsChanged: true,
+		})
+
+		return diffPosition.AddUnsafe(doc.Apply(Changeset{
+			Id:        changeset.Id,
+			NewText:   newText,
+			StartPos:  changeset.StartPos,
+			EndPos:    changeset.StartPos,
+			IsChanged: true,
+		}))
+	}
+
 	// if the changeset is a newline, split the new text into lines and apply them one by one
 	nlCount := strings.Count(changeset.NewText, "\n")
 	hasTrailingNewLine := strings.HasSuffix(changeset.NewText, "\n")
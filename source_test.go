@@ -515,6 +515,78 @@ func TestEditableDocument(t *testing.T) {
 		}
 	})
 
+	t.Run("EditableDocument.ReplaceLineRange", func(t *testing.T) {
+		editableDoc := doc.Editable()
+
+		editableDoc.Apply(Changeset{
+			NewText: "if {\n\tb = 2\n\tc = 3\n}\n",
+			StartPos: Position{
+				Line:   0,
+				Column: 0,
+				Index:  0,
+			},
+			EndPos: Position{
+				Line:   0,
+				Column: 0,
+				Index:  0,
+			},
+		})
+
+		if editableDoc.String() != "if {\n\tb = 2\n\tc = 3\n}\nhello = 1" {
+			t.Errorf("Expected contents to be \"if {\\n\\tb = 2\\n\\tc = 3\\n}\\nhello = 1\", got %q", editableDoc.String())
+		}
+
+		editableDoc.Apply(Changeset{
+			NewText: "d = 4",
+			StartPos: Position{
+				Line:   0,
+				Column: 0,
+			},
+			EndPos: Position{
+				Line:   3,
+				Column: 1,
+			},
+		})
+
+		if editableDoc.String() != "d = 4\nhello = 1" {
+			t.Errorf("Expected contents to be \"d = 4\\nhello = 1\", got %q", editableDoc.String())
+		}
+	})
+
+	t.Run("EditableDocument.ReplaceLineRangeMiddle", func(t *testing.T) {
+		editableDoc := doc.Editable()
+
+		editableDoc.Apply(Changeset{
+			NewText: "a = if {\n\tb = 2\n}\n",
+			StartPos: Position{
+				Line:   0,
+				Column: 0,
+				Index:  0,
+			},
+			EndPos: Position{
+				Line:   0,
+				Column: 0,
+				Index:  0,
+			},
+		})
+
+		editableDoc.Apply(Changeset{
+			NewText: "c",
+			StartPos: Position{
+				Line:   0,
+				Column: 4,
+			},
+			EndPos: Position{
+				Line:   2,
+				Column: 1,
+			},
+		})
+
+		if editableDoc.String() != "a = c\nhello = 1" {
+			t.Errorf("Expected contents to be \"a = c\\nhello = 1\", got %q", editableDoc.String())
+		}
+	})
+
 	t.Run("EditableDocument.WrapWithBlock", func(t *testing.T) {
 		editableDoc := doc.Editable()
 
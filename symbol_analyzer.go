@@ -378,18 +378,35 @@ func (an *SymbolAnalyzer) captureAndAnalyze(parent *SymbolTree, rootNode SyntaxN
 		it := &captureIterator{doc: an.doc, captures: q.Match().Captures()}
 		it.Reset()
 
-		nearest := parent.GetNearestScopedTree(int(it.Get(0).Node.StartByte()))
+		firstNode := it.Get(0).Node
+		nearest := parent.GetNearestScopedTree(int(firstNode.StartByte()))
+
+		// a node spanning multiple matches (eg. a class with several methods)
+		// may already have its own scope. use the parent scope instead to
+		// avoid adding the node into itself
+		if nearest.Parent != nil && nearest.StartPos.Index == int(firstNode.StartByte()) && nearest.EndPos.Index == int(firstNode.EndByte()) {
+			nearest = nearest.Parent
+		}
 		an.analyzeUnknown(nearest, q.Query(), it)
 	}
 }
 
 func (an *SymbolAnalyzer) Analyze(doc *Document) {
 	oldCurrentDocumentPath := an.ContextData.CurrentDocumentPath
+	oldAnalyzer := an.ContextData.Analyzer
 
 	an.doc = doc
 	rootNode := doc.RootNode()
 	symTree := an.ContextData.InitOrGetSymbolTree(an.doc.Path)
 	an.ContextData.CurrentDocumentPath = an.doc.Path
+
+	// documents from other languages (eg. from mixed-language stack traces)
+	// must be analyzed with the analyzer of their own language
+	if an.doc.Language.AnalyzerFactory != nil {
+		an.ContextData.Analyzer = an.doc.Language.AnalyzerFactory(an.ContextData)
+	}
+
 	an.captureAndAnalyze(symTree, rootNode, an.doc.Language.SymbolsToCapture)
 	an.ContextData.CurrentDocumentPath = oldCurrentDocumentPath
+	an.ContextData.Analyzer = oldAnalyzer
 }
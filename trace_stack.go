@@ -29,6 +29,24 @@ func (st TraceStack) NearestTo(path string) StackTraceEntry {
 	return st.Top()
 }
 
+// Cycle returns the shortest sequence of frames that repeats itself at
+// the start of the stack (eg. the frames of a recursive call which
+// caused a stack overflow) or nil if the stack has no repeating frames.
+func (st TraceStack) Cycle() TraceStack {
+	for period := 1; period*2 <= len(st); period++ {
+		matches := 0
+		for i := 0; i+period < len(st) && st[i] == st[i+period]; i++ {
+			matches++
+		}
+
+		// the frames should repeat at least twice
+		if matches >= period {
+			return st[:period]
+		}
+	}
+	return nil
+}
+
 type StackTraceEntry struct {
 	Location
 	SymbolName string
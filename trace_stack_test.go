@@ -53,3 +53,31 @@ func TestTraceStack(t *testing.T) {
 		},
 	})
 }
+
+func TestTraceStackCycle(t *testing.T) {
+	entry := func(name string, line int) StackTraceEntry {
+		return StackTraceEntry{
+			SymbolName: name,
+			Location: Location{
+				DocumentPath: "Main.java",
+				StartPos:     Position{Line: line},
+				EndPos:       Position{Line: line},
+			},
+		}
+	}
+
+	t.Run("Recursion", func(t *testing.T) {
+		stack := TraceStack{entry("a", 3), entry("a", 3), entry("a", 3), entry("main", 7)}
+		testutils.EqualsList(t, stack.Cycle(), TraceStack{entry("a", 3)})
+	})
+
+	t.Run("MutualRecursion", func(t *testing.T) {
+		stack := TraceStack{entry("a", 3), entry("b", 9), entry("a", 3), entry("b", 9), entry("a", 3)}
+		testutils.EqualsList(t, stack.Cycle(), TraceStack{entry("a", 3), entry("b", 9)})
+	})
+
+	t.Run("None", func(t *testing.T) {
+		stack := TraceStack{entry("a", 3), entry("b", 9), entry("main", 7)}
+		testutils.EqualsList(t, stack.Cycle(), nil)
+	})
+}
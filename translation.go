@@ -116,14 +116,21 @@ func (step *BugFixStep) AddFix(fix FixSuggestion) *BugFixStep {
 
 	step.Fixes = append(step.Fixes, fix)
 
+	// the last line is left untouched if the fix ends at its beginning
+	// (eg. when removing whole lines)
+	endLine := fix.EndPosition.Line
+	if fix.EndPosition.Column == 0 && endLine > fix.StartPosition.Line {
+		endLine--
+	}
+
 	if !step.isSet {
 		// get the start and end line after applying the diff
-		step.StartLine = step.Fixes[0].StartPosition.Line
-		step.AfterLine = step.Fixes[0].EndPosition.Line
+		step.StartLine = fix.StartPosition.Line
+		step.AfterLine = endLine
 
 		// get the original start and end line
-		step.OrigStartLine = step.Fixes[0].StartPosition.Line
-		step.OrigAfterLine = step.Fixes[0].EndPosition.Line
+		step.OrigStartLine = fix.StartPosition.Line
+		step.OrigAfterLine = endLine
 
 		if !step.isCopyable {
 			// set diff position
@@ -147,9 +154,18 @@ func (step *BugFixStep) AddFix(fix FixSuggestion) *BugFixStep {
 	// do not adjust position if the current fix is above the previous fix position
 	// if fIdx >= 0 && step.Fixes[fIdx-1].StartPosition.Line <= fix.StartPosition.Line {
 	if fIdx-1 >= 0 {
-		changeset = changeset.Add(step.DiffPosition)
+		diff := step.DiffPosition
+		if prevFix := step.Fixes[fIdx-1]; fix.StartPosition.Line > prevFix.EndPosition.Line &&
+			prevFix.StartPosition.Line == prevFix.EndPosition.Line && !strings.Contains(prevFix.NewText, "\n") {
+			// the columns of the line are not moved by a previous fix which
+			// only changes a single line above it
+			diff = Position{Line: diff.Line}
+		}
+		changeset = changeset.Add(diff)
 	}
 
+	// the start of the fix is only moved by the fixes before it
+	prevDiffLine := step.DiffPosition.Line
 	step.DiffPosition = step.DiffPosition.AddUnsafe(step.Doc.Apply(changeset))
 
 	// change origStartLine only if
@@ -160,14 +176,14 @@ func (step *BugFixStep) AddFix(fix FixSuggestion) *BugFixStep {
 		step.OrigStartLine = origStartLine2
 	}
 
-	step.OrigAfterLine = max(step.OrigAfterLine, fix.EndPosition.Line)
-	step.StartLine = min(step.StartLine, fix.StartPosition.Line+step.DiffPosition.Line)
+	step.OrigAfterLine = max(step.OrigAfterLine, endLine)
+	step.StartLine = min(step.StartLine, fix.StartPosition.Line+prevDiffLine)
 
 	// if the diff position is negative, we need to set the after line to the latest position
 	if step.DiffPosition.Line < 0 {
-		step.AfterLine = fix.EndPosition.Line + step.DiffPosition.Line
+		step.AfterLine = endLine + step.DiffPosition.Line
 	} else {
-		step.AfterLine = max(step.AfterLine, fix.EndPosition.Line+step.DiffPosition.Line)
+		step.AfterLine = max(step.AfterLine, endLine+step.DiffPosition.Line)
 	}
 
 	if !step.isCopyable {
@@ -475,6 +475,36 @@ func TestBugFixGenerator(t *testing.T) {
 		})
 	})
 
+	t.Run("Suggestion/AddFix/MultipleLines", func(t *testing.T) {
+		multiLineDoc, err := lib.ParseDocument("indent.test", strings.NewReader("if a:\n\tb()\n\tc()"), parser, lib.TestLanguage, nil)
+		if err != nil {
+			t.Errorf("Error parsing document: %s", err)
+		}
+
+		gen := &lib.BugFixGenerator{
+			Document: multiLineDoc,
+		}
+
+		gen.Add("A descriptive suggestion sentence or phrase", func(s *lib.BugFixSuggestion) {
+			// replaces the tabs of each line with spaces
+			step := s.AddStep("This is a step.").
+				AddFix(lib.FixSuggestion{
+					NewText:       "    ",
+					StartPosition: lib.Position{Line: 1},
+					EndPosition:   lib.Position{Line: 1, Column: 1},
+				}).
+				AddFix(lib.FixSuggestion{
+					NewText:       "    ",
+					StartPosition: lib.Position{Line: 2},
+					EndPosition:   lib.Position{Line: 2, Column: 1},
+				})
+
+			if step.Doc.String() != "if a:\n    b()\n    c()" {
+				t.Errorf("Expected 'if a:\n    b()\n    c()', got %s", step.Doc.String())
+			}
+		})
+	})
+
 	t.Run("Suggestion/MultipleSteps/AddFix", func(t *testing.T) {
 		gen := &lib.BugFixGenerator{
 			Document: doc,
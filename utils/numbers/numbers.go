@@ -1,6 +1,8 @@
 package numbers
 
 import (
+	"math"
+	"math/big"
 	"strings"
 )
 
@@ -118,3 +120,49 @@ func twoDigitNumberToWords(num int) string {
 func oneDigitNumberToWords(num int) string {
 	return onesWords[num]
 }
+
+var (
+	MaxInt32 = big.NewInt(math.MaxInt32)
+	MinInt32 = big.NewInt(math.MinInt32)
+	MaxInt64 = big.NewInt(math.MaxInt64)
+	MinInt64 = big.NewInt(math.MinInt64)
+)
+
+// ParseInteger parses an integer literal which may contain underscores, a
+// radix prefix (`0x`, `0b` or a leading `0` for octal) and a `L` suffix.
+func ParseInteger(literal string) (value *big.Int, isLong bool, ok bool) {
+	text := strings.ReplaceAll(literal, "_", "")
+	if strings.HasSuffix(text, "L") || strings.HasSuffix(text, "l") {
+		text = text[:len(text)-1]
+		isLong = true
+	}
+
+	base := 10
+	switch {
+	case strings.HasPrefix(text, "0x") || strings.HasPrefix(text, "0X"):
+		base, text = 16, text[2:]
+	case strings.HasPrefix(text, "0b") || strings.HasPrefix(text, "0B"):
+		base, text = 2, text[2:]
+	case len(text) > 1 && strings.HasPrefix(text, "0"):
+		base, text = 8, text[1:]
+	}
+
+	value, ok = new(big.Int).SetString(text, base)
+	return value, isLong, ok
+}
+
+// FitsInt32 checks if the value is within the range of a 32-bit integer
+func FitsInt32(value *big.Int) bool {
+	return value.Cmp(MinInt32) >= 0 && value.Cmp(MaxInt32) <= 0
+}
+
+// FitsInt64 checks if the value is within the range of a 64-bit integer
+func FitsInt64(value *big.Int) bool {
+	return value.Cmp(MinInt64) >= 0 && value.Cmp(MaxInt64) <= 0
+}
+
+// WrapInt32 returns the value as it would be stored in a 32-bit integer
+// after it overflows
+func WrapInt32(value *big.Int) int32 {
+	return int32(uint32(new(big.Int).And(value, big.NewInt(math.MaxUint32)).Uint64()))
+}